@@ -0,0 +1,76 @@
+// Package authsign 客户端请求的 HMAC 签名
+//
+// 签名串为参数按名称排序后的 "name=value" 用 "&" 连接（不包含 signature 本身），
+// 签名为 HMAC-SHA256(密钥, 签名串) 的小写十六进制，例如：
+//
+//	app_id=desktop_app&client_version=1.0.0&device_id=d1&...&token=abc
+package authsign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Canonical 生成签名串
+func Canonical(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(params[name])
+	}
+	return b.String()
+}
+
+// Sign 计算签名
+func Sign(secret string, params map[string]string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(Canonical(params)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验签名，比较耗时与签名内容无关
+func Verify(secret string, params map[string]string, signature string) bool {
+	expected := Sign(secret, params)
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
+
+// ErrInvalidSecrets 密钥配置格式错误
+var ErrInvalidSecrets = errors.New("invalid app secrets")
+
+// ParseSecrets 解析 "app_id:secret,app_id:secret" 格式的应用密钥配置
+func ParseSecrets(value string) (map[string]string, error) {
+	secrets := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		appID, secret, ok := strings.Cut(item, ":")
+		if !ok || appID == "" || secret == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSecrets, item)
+		}
+		secrets[appID] = secret
+	}
+	return secrets, nil
+}
+
+// LoadSecretsFromEnv 从环境变量读取应用密钥配置，未设置时返回空表
+func LoadSecretsFromEnv(name string) (map[string]string, error) {
+	return ParseSecrets(os.Getenv(name))
+}
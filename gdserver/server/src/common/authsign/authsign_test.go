@@ -0,0 +1,40 @@
+package authsign
+
+import "testing"
+
+func TestSignAndVerify(t *testing.T) {
+	params := map[string]string{
+		"token":     "abc",
+		"app_id":    "desktop_app",
+		"nonce":     "n1",
+		"timestamp": "1700000000000",
+	}
+	if got := Canonical(params); got != "app_id=desktop_app&nonce=n1&timestamp=1700000000000&token=abc" {
+		t.Fatalf("canonical = %q", got)
+	}
+
+	sig := Sign("secret", params)
+	if !Verify("secret", params, sig) {
+		t.Fatal("valid signature rejected")
+	}
+	if Verify("other", params, sig) {
+		t.Fatal("signature accepted with wrong secret")
+	}
+	params["nonce"] = "n2"
+	if Verify("secret", params, sig) {
+		t.Fatal("signature accepted after params changed")
+	}
+}
+
+func TestParseSecrets(t *testing.T) {
+	secrets, err := ParseSecrets(" desktop_app:s1, minigame:s2:x ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secrets) != 2 || secrets["desktop_app"] != "s1" || secrets["minigame"] != "s2:x" {
+		t.Fatalf("secrets = %v", secrets)
+	}
+	if _, err := ParseSecrets("desktop_app"); err == nil {
+		t.Fatal("missing secret should fail")
+	}
+}
@@ -0,0 +1,104 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"common/redisutil"
+)
+
+const (
+	BattleServiceName  = "battle-server"      // 战斗服在服务发现中的名称
+	roomOwnerKeyPrefix = "battle_room_owner:" // 房间归属 battle_room_owner:{room_id} -> 战斗服地址
+	battleLoadKey      = "battle_load"        // 战斗服负载有序集合 instance_id -> 房间数
+)
+
+// ErrNoBattleInstance 没有可用的战斗服实例
+var ErrNoBattleInstance = errors.New("no battle server instance available")
+
+// BattleRouter 战斗服路由：创建房间时按负载选择实例，房间内请求按归属路由到对应实例
+type BattleRouter struct {
+	disc   *RedisDiscovery
+	redis  *redisutil.RedisPool
+	prefix string
+}
+
+func NewBattleRouter(redisPool *redisutil.RedisPool, prefix string) *BattleRouter {
+	return &BattleRouter{
+		disc:   NewRedisDiscovery(redisPool, prefix),
+		redis:  redisPool,
+		prefix: prefix,
+	}
+}
+
+func (r *BattleRouter) buildKey(key string) string {
+	return r.prefix + key
+}
+
+// Instances 获取所有存活的战斗服实例
+func (r *BattleRouter) Instances(ctx context.Context) ([]*ServiceInstance, error) {
+	return r.disc.Discover(ctx, BattleServiceName)
+}
+
+// PickInstance 选择房间数最少的战斗服实例，用于创建新房间
+func (r *BattleRouter) PickInstance(ctx context.Context) (*ServiceInstance, error) {
+	instances, err := r.Instances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) == 0 {
+		return nil, ErrNoBattleInstance
+	}
+
+	loads, err := r.redis.ZRangeByScoreWithScores(r.buildKey(battleLoadKey), 0, math.MaxInt64)
+	if err != nil {
+		return nil, fmt.Errorf("获取战斗服负载失败: %w", err)
+	}
+
+	// 未上报负载的实例视为空闲
+	best := instances[0]
+	for _, instance := range instances[1:] {
+		if loads[instance.InstanceID] < loads[best.InstanceID] {
+			best = instance
+		}
+	}
+	return best, nil
+}
+
+// RoomOwner 查询房间所在战斗服的地址
+func (r *BattleRouter) RoomOwner(roomID string) (string, error) {
+	if roomID == "" {
+		return "", redisutil.ErrKeyNotFound
+	}
+	return r.redis.GetString(r.buildKey(roomOwnerKeyPrefix + roomID))
+}
+
+// ====================== 战斗服侧 ====================== //
+
+// SetRoomOwner 记录房间归属的战斗服地址，ttl 后过期，防止实例异常退出后残留
+func (r *BattleRouter) SetRoomOwner(roomID, address string, ttl time.Duration) error {
+	return r.redis.SetEx(r.buildKey(roomOwnerKeyPrefix+roomID), address, ttl)
+}
+
+// RefreshRoomOwner 延长房间归属记录的过期时间
+func (r *BattleRouter) RefreshRoomOwner(roomID string, ttl time.Duration) error {
+	return r.redis.Expire(r.buildKey(roomOwnerKeyPrefix+roomID), ttl)
+}
+
+// DeleteRoomOwner 房间关闭后删除归属记录
+func (r *BattleRouter) DeleteRoomOwner(roomID string) error {
+	return r.redis.Delete(r.buildKey(roomOwnerKeyPrefix + roomID))
+}
+
+// ReportLoad 上报战斗服当前的房间数
+func (r *BattleRouter) ReportLoad(instanceID string, roomCount int) error {
+	return r.redis.ZAdd(r.buildKey(battleLoadKey), int64(roomCount), instanceID)
+}
+
+// RemoveLoad 战斗服下线时移除负载记录
+func (r *BattleRouter) RemoveLoad(instanceID string) error {
+	return r.redis.ZRem(r.buildKey(battleLoadKey), instanceID)
+}
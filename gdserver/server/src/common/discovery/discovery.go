@@ -6,6 +6,9 @@ import (
 	"time"
 )
 
+// MatchServiceName 匹配服在服务发现中的名称
+const MatchServiceName = "match-server"
+
 // ServiceInstance 表示一个服务实例
 type ServiceInstance struct {
 	ServiceName string            // 服务名称 (如 "battle-server")
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"common/redisutil"
@@ -109,10 +110,15 @@ func (r *RedisDiscovery) getInstanceByID(instanceID string) (*ServiceInstance, e
 			continue
 		}
 
-		// 获取实例数据
-		instanceKey := r.buildKey(serviceKeyPrefix + serviceKey + ":" + instanceID)
+		// 获取实例数据（serviceKey 已包含前缀）
+		instanceKey := serviceKey + ":" + instanceID
 		data, err := r.redis.GetString(instanceKey)
 		if err != nil {
+			if errors.Is(err, redisutil.ErrKeyNotFound) {
+				// 实例数据已过期，仍返回服务名以便清理集合和心跳
+				serviceName := strings.TrimPrefix(serviceKey, r.buildKey(serviceKeyPrefix))
+				return &ServiceInstance{ServiceName: serviceName, InstanceID: instanceID}, nil
+			}
 			return nil, fmt.Errorf("获取实例数据失败: %w", err)
 		}
 
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Serve 在独立端口启动 /metrics，每个服务在 main 中调用一次即可
+// 监听地址可通过 METRICS_ADDR 覆盖，设置为 off 时不启动
+func Serve(defaultAddr string) {
+	addr := defaultAddr
+	if v := os.Getenv("METRICS_ADDR"); v != "" {
+		addr = v
+	}
+	if addr == "off" {
+		slog.Info("Metrics endpoint disabled")
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		slog.Info("Metrics endpoint started", "addr", addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Metrics endpoint stopped", "addr", addr, "error", err)
+		}
+	}()
+}
+
+// Handler 以 Prometheus 格式输出默认注册表中的指标，可以挂到已有的 HTTP 服务上
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Default, promhttp.HandlerOpts{ErrorLog: errorLogger{}})
+}
+
+// errorLogger 将采集错误写入 slog
+type errorLogger struct{}
+
+func (errorLogger) Println(v ...interface{}) {
+	slog.Warn("Failed to write metrics", "error", fmt.Sprint(v...))
+}
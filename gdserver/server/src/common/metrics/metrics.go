@@ -0,0 +1,118 @@
+// Package metrics 进程内指标（计数器、仪表盘、直方图），基于 Prometheus 客户端库，通过 /metrics 暴露
+//
+// 对客户端库做了一层薄封装，各服务只依赖这里的注册函数：
+//
+//	var msgTotal = metrics.NewCounterVec("game_messages_total", "按消息ID统计收到的消息数", "msg_id")
+//	msgTotal.WithLabelValues("AUTH_REQUEST").Inc()
+//
+// 指标在包初始化时注册到默认 Registry，同名指标重复注册会 panic
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// DefBuckets 默认的耗时直方图分桶（秒）
+var DefBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// NewRegistry 创建注册表，包含 Go 运行时和进程指标（协程数、内存、启动时间等）
+func NewRegistry() *prometheus.Registry {
+	r := prometheus.NewRegistry()
+	r.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return r
+}
+
+// Default 默认注册表，NewXxx 系列函数注册到这里
+var Default = NewRegistry()
+
+// ====================== 计数器 ====================== //
+
+// Counter 只增不减的计数器
+type Counter = prometheus.Counter
+
+// CounterVec 带标签的计数器
+type CounterVec = prometheus.CounterVec
+
+// NewCounter 注册无标签的计数器
+func NewCounter(name, help string) Counter {
+	c := prometheus.NewCounter(prometheus.CounterOpts{Name: name, Help: help})
+	Default.MustRegister(c)
+	return c
+}
+
+// NewCounterVec 注册带标签的计数器
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	v := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)
+	Default.MustRegister(v)
+	return v
+}
+
+// ====================== 仪表盘 ====================== //
+
+// Gauge 可增可减的当前值
+type Gauge = prometheus.Gauge
+
+// GaugeVec 带标签的仪表盘
+type GaugeVec = prometheus.GaugeVec
+
+// NewGauge 注册无标签的仪表盘
+func NewGauge(name, help string) Gauge {
+	g := prometheus.NewGauge(prometheus.GaugeOpts{Name: name, Help: help})
+	Default.MustRegister(g)
+	return g
+}
+
+// NewGaugeVec 注册带标签的仪表盘
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	v := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)
+	Default.MustRegister(v)
+	return v
+}
+
+// NewGaugeFunc 注册采集时计算的仪表盘（如在线人数、房间数），fn 需要并发安全且尽快返回
+func NewGaugeFunc(name, help string, fn func() float64) {
+	Default.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, fn))
+}
+
+// ====================== 直方图 ====================== //
+
+// Histogram 分布统计（耗时、等待时间等）
+type Histogram struct {
+	prometheus.Observer
+}
+
+// ObserveSince 记录从 start 到现在经过的秒数
+func (h Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// HistogramVec 带标签的直方图
+type HistogramVec struct {
+	v *prometheus.HistogramVec
+}
+
+// NewHistogram 注册无标签的直方图，buckets 为空时使用 DefBuckets
+func NewHistogram(name, help string, buckets []float64) Histogram {
+	return NewHistogramVec(name, help, buckets).WithLabelValues()
+}
+
+// NewHistogramVec 注册带标签的直方图，buckets 为空时使用 DefBuckets
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	v := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)
+	Default.MustRegister(v)
+	return &HistogramVec{v: v}
+}
+
+// WithLabelValues 获取标签值对应的直方图
+func (v *HistogramVec) WithLabelValues(values ...string) Histogram {
+	return Histogram{Observer: v.v.WithLabelValues(values...)}
+}
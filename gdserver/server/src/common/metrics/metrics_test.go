@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	old := Default
+	Default = NewRegistry()
+	defer func() { Default = old }()
+
+	msgs := NewCounterVec("test_messages_total", "消息数", "msg_id")
+	latency := NewHistogramVec("test_latency_seconds", "耗时", []float64{0.1, 1}, "msg_id")
+	queue := NewGauge("test_queue_size", "队列长度")
+	NewGaugeFunc("test_players", "在线人数", func() float64 { return 3 })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			msgs.WithLabelValues("AUTH_REQUEST").Inc()
+		}()
+	}
+	wg.Wait()
+	msgs.WithLabelValues(`a"b`).Add(2)
+	latency.WithLabelValues("AUTH_REQUEST").Observe(0.05)
+	latency.WithLabelValues("AUTH_REQUEST").Observe(0.1)
+	latency.WithLabelValues("AUTH_REQUEST").Observe(5)
+	queue.Set(7)
+	queue.Dec()
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	out := rec.Body.String()
+
+	for _, want := range []string{
+		"# TYPE test_messages_total counter\n",
+		`test_messages_total{msg_id="AUTH_REQUEST"} 10` + "\n",
+		`test_messages_total{msg_id="a\"b"} 2` + "\n",
+		`test_latency_seconds_bucket{msg_id="AUTH_REQUEST",le="0.1"} 2` + "\n",
+		`test_latency_seconds_bucket{msg_id="AUTH_REQUEST",le="1"} 2` + "\n",
+		`test_latency_seconds_bucket{msg_id="AUTH_REQUEST",le="+Inf"} 3` + "\n",
+		`test_latency_seconds_count{msg_id="AUTH_REQUEST"} 3` + "\n",
+		"test_queue_size 6\n",
+		"test_players 3\n",
+		"# TYPE go_goroutines gauge\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestDuplicateMetricPanics(t *testing.T) {
+	old := Default
+	Default = NewRegistry()
+	defer func() { Default = old }()
+
+	NewCounter("test_dup_total", "")
+	defer func() {
+		if recover() == nil {
+			t.Fatal("duplicate registration did not panic")
+		}
+	}()
+	NewCounter("test_dup_total", "")
+}
@@ -1,11 +1,14 @@
 package redisutil
 
 import (
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/garyburd/redigo/redis"
@@ -209,6 +212,41 @@ func (rp *RedisPool) GetJSON(key string, dest interface{}) error {
 	return json.Unmarshal([]byte(jsonData), dest)
 }
 
+// GetDelString 获取字符串值并删除键（事务内执行），同一个键并发调用时只有一个能取到值
+func (rp *RedisPool) GetDelString(key string) (string, error) {
+	conn := rp.pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("GET", key)
+	conn.Send("DEL", key)
+	results, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return "", fmt.Errorf("redis transaction failed: %w", err)
+	}
+	if len(results) < 1 {
+		return "", errors.New("invalid transaction results")
+	}
+
+	value, err := redis.String(results[0], nil)
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", ErrKeyNotFound
+		}
+		return "", fmt.Errorf("redis GET failed: %w", err)
+	}
+	return value, nil
+}
+
+// GetDelJSON 获取JSON值并删除键，见 GetDelString
+func (rp *RedisPool) GetDelJSON(key string, dest interface{}) error {
+	jsonData, err := rp.GetDelString(key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(jsonData), dest)
+}
+
 // SetProto 设置Protobuf值
 func (rp *RedisPool) SetProto(key string, value proto.Message, expiration time.Duration) error {
 	data, err := proto.Marshal(value)
@@ -331,26 +369,23 @@ func (rp *RedisPool) Publish(channel, message string) error {
 	return nil
 }
 
-// Subscribe 订阅频道
+// Subscribe 订阅频道，handler 在订阅协程中依次调用
+// 订阅连接断开后每秒重试重新订阅，断开期间发布的消息会丢失；只有第一次订阅失败时返回错误
 func (rp *RedisPool) Subscribe(channel string, handler func(string)) error {
-	conn := rp.pool.Get()
-	defer conn.Close()
-
-	psc := redis.PubSubConn{Conn: conn}
-	if err := psc.Subscribe(channel); err != nil {
-		return fmt.Errorf("redis SUBSCRIBE failed: %w", err)
+	psc, err := rp.subscribe(channel)
+	if err != nil {
+		return err
 	}
 
 	go func() {
-		defer psc.Close()
 		for {
-			switch v := psc.Receive().(type) {
-			case redis.Message:
-				handler(string(v.Data))
-			case redis.Subscription:
-				// 订阅状态变化
-			case error:
-				return
+			receiveMessages(psc, handler)
+			psc.Close()
+			for {
+				time.Sleep(time.Second)
+				if psc, err = rp.subscribe(channel); err == nil {
+					break
+				}
 			}
 		}
 	}()
@@ -358,6 +393,29 @@ func (rp *RedisPool) Subscribe(channel string, handler func(string)) error {
 	return nil
 }
 
+func (rp *RedisPool) subscribe(channel string) (*redis.PubSubConn, error) {
+	psc := &redis.PubSubConn{Conn: rp.pool.Get()}
+	if err := psc.Subscribe(channel); err != nil {
+		psc.Close()
+		return nil, fmt.Errorf("redis SUBSCRIBE failed: %w", err)
+	}
+	return psc, nil
+}
+
+// receiveMessages 接收消息直到连接出错
+func receiveMessages(psc *redis.PubSubConn, handler func(string)) {
+	for {
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			handler(string(v.Data))
+		case redis.Subscription:
+			// 订阅状态变化
+		case error:
+			return
+		}
+	}
+}
+
 // GenerateBattleID 生成全局唯一战斗ID
 func (rp *RedisPool) GenerateBattleID() (string, error) {
 	conn := rp.pool.Get()
@@ -375,6 +433,60 @@ func (rp *RedisPool) GenerateBattleID() (string, error) {
 	return fmt.Sprintf("%d", id), nil
 }
 
+const (
+	roomInviteKeyPrefix = "room_invite:"
+	// 邀请码字符集，去掉了容易混淆的 0/O/1/I/L
+	inviteCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+	inviteCodeLength   = 6
+)
+
+// GenerateRoomInviteCode 为房间生成短随机邀请码，写入Redis并设置过期时间
+func (rp *RedisPool) GenerateRoomInviteCode(roomID string, expiration time.Duration) (string, error) {
+	// 冲突概率很低，重试几次即可
+	for i := 0; i < 5; i++ {
+		code, err := randomInviteCode()
+		if err != nil {
+			return "", err
+		}
+		ok, err := rp.SetNXEx(roomInviteKeyPrefix+code, roomID, expiration)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return code, nil
+		}
+	}
+	return "", errors.New("failed to allocate unique invite code")
+}
+
+// GetRoomIDByInviteCode 根据邀请码查找房间ID（邀请码不区分大小写）
+func (rp *RedisPool) GetRoomIDByInviteCode(code string) (string, error) {
+	return rp.GetString(roomInviteKeyPrefix + strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// RefreshRoomInviteCode 刷新邀请码的过期时间
+func (rp *RedisPool) RefreshRoomInviteCode(code string, expiration time.Duration) error {
+	return rp.Expire(roomInviteKeyPrefix+code, expiration)
+}
+
+// DeleteRoomInviteCode 删除邀请码
+func (rp *RedisPool) DeleteRoomInviteCode(code string) error {
+	return rp.Delete(roomInviteKeyPrefix + code)
+}
+
+func randomInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeLength)
+	max := big.NewInt(int64(len(inviteCodeAlphabet)))
+	for i := range buf {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("generate invite code failed: %w", err)
+		}
+		buf[i] = inviteCodeAlphabet[n.Int64()]
+	}
+	return string(buf), nil
+}
+
 // ====================== 新增方法 ====================== //
 
 // ZAdd 向有序集合添加成员
@@ -537,6 +649,21 @@ func (rp *RedisPool) SetNX(key, value string) (bool, error) {
 	return result == 1, nil
 }
 
+// SetNXEx 设置键值并设置过期时间，仅当键不存在时
+func (rp *RedisPool) SetNXEx(key, value string, expiration time.Duration) (bool, error) {
+	conn := rp.pool.Get()
+	defer conn.Close()
+
+	_, err := redis.String(conn.Do("SET", key, value, "NX", "EX", int(expiration.Seconds())))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, fmt.Errorf("redis SET NX EX failed: %w", err)
+	}
+	return true, nil
+}
+
 // 错误定义
 var (
 	ErrKeyNotFound = errors.New("key not found")
@@ -0,0 +1,66 @@
+// Package roomlist 房间列表排序与分页游标，BattleServer 分页和 GameServer 跨实例合并共用
+package roomlist
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+const (
+	DefaultPageSize = 20  // 房间列表默认每页数量
+	MaxPageSize     = 100 // 房间列表每页最大数量
+)
+
+// Cursor 分页游标，记录上一页最后一个房间的排序键
+// 排序规则统一为：Primary 降序 -> Created 降序 -> ID 升序
+type Cursor struct {
+	Primary int64
+	Created int64
+	ID      string
+}
+
+// NewCursor 根据排序方式生成房间的排序键
+// fullest 为 true 时按当前人数排序，否则按创建时间排序
+func NewCursor(fullest bool, createTime int64, currentPlayers int32, id string) Cursor {
+	c := Cursor{Primary: createTime, Created: createTime, ID: id}
+	if fullest {
+		c.Primary = int64(currentPlayers)
+	}
+	return c
+}
+
+// Before 判断 c 在排序结果中是否排在 other 之前
+func (c Cursor) Before(other Cursor) bool {
+	if c.Primary != other.Primary {
+		return c.Primary > other.Primary
+	}
+	if c.Created != other.Created {
+		return c.Created > other.Created
+	}
+	return c.ID < other.ID
+}
+
+// Encode 编码为对客户端不透明的游标字符串
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d|%d|%s", c.Primary, c.Created, c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode 解析游标字符串
+func Decode(s string) (Cursor, error) {
+	var c Cursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	parts := strings.SplitN(string(raw), "|", 3)
+	if len(parts) != 3 {
+		return c, fmt.Errorf("invalid cursor: %q", raw)
+	}
+	if _, err := fmt.Sscanf(parts[0]+" "+parts[1], "%d %d", &c.Primary, &c.Created); err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	c.ID = parts[2]
+	return c, nil
+}
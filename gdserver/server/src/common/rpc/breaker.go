@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// BreakerConfig 熔断器配置
+type BreakerConfig struct {
+	FailureThreshold int           // 连续失败多少次后熔断
+	OpenTimeout      time.Duration // 熔断后多久允许一次探测请求
+}
+
+// DefaultBreakerConfig 默认熔断配置：连续 5 次失败后熔断 10 秒
+var DefaultBreakerConfig = BreakerConfig{
+	FailureThreshold: 5,
+	OpenTimeout:      10 * time.Second,
+}
+
+// BreakerState 熔断器状态
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota // 正常放行
+	BreakerOpen                         // 熔断中，请求直接失败
+	BreakerHalfOpen                     // 熔断到期，放行一个探测请求
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker 单个下游实例的熔断器
+// 下游不可用时请求快速失败，不再让每个玩家消息都等到超时
+type Breaker struct {
+	mu       sync.Mutex
+	cfg      BreakerConfig
+	state    BreakerState
+	failures int       // 连续失败次数
+	openedAt time.Time // 进入熔断的时间
+	probing  bool      // 半开状态下是否已有探测请求在进行
+	now      func() time.Time
+}
+
+func NewBreaker(cfg BreakerConfig) *Breaker {
+	return &Breaker{cfg: cfg, now: time.Now}
+}
+
+// Allow 是否放行请求，放行后必须调用 Done 回报结果
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout {
+			return false
+		}
+		b.state = BreakerHalfOpen
+		b.probing = true
+		return true
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Done 回报请求结果，code 为 gRPC 状态码（成功为 codes.OK）
+func (b *Breaker) Done(code codes.Code) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if code == codes.Canceled {
+		// 调用方放弃了请求，无法判断下游是否健康
+		b.probing = false
+		return
+	}
+	if !isBreakerFailure(code) {
+		b.state = BreakerClosed
+		b.failures = 0
+		b.probing = false
+		return
+	}
+
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.state = BreakerOpen
+		b.openedAt = b.now()
+		b.probing = false
+	}
+}
+
+// State 当前状态
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// isBreakerFailure 只有下游不可用或超时才计入熔断，业务错误（参数、权限等）说明下游是健康的
+func isBreakerFailure(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
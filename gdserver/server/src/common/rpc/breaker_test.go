@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreakerTransitions(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewBreaker(BreakerConfig{FailureThreshold: 3, OpenTimeout: time.Second})
+	b.now = func() time.Time { return now }
+
+	// 业务错误不计入熔断
+	for i := 0; i < 5; i++ {
+		if !b.Allow() {
+			t.Fatal("closed breaker rejected request")
+		}
+		b.Done(codes.NotFound)
+	}
+
+	for i := 0; i < 3; i++ {
+		b.Allow()
+		b.Done(codes.Unavailable)
+	}
+	if b.State() != BreakerOpen || b.Allow() {
+		t.Fatalf("state = %s, want open and rejecting", b.State())
+	}
+
+	// 到期后只放行一个探测请求，探测失败重新熔断
+	now = now.Add(time.Second)
+	if !b.Allow() {
+		t.Fatal("probe rejected after open timeout")
+	}
+	if b.Allow() {
+		t.Fatal("second request allowed while probing")
+	}
+	b.Done(codes.DeadlineExceeded)
+	if b.State() != BreakerOpen || b.Allow() {
+		t.Fatalf("failed probe: state = %s, want open", b.State())
+	}
+
+	// 探测成功后恢复
+	now = now.Add(time.Second)
+	b.Allow()
+	b.Done(codes.OK)
+	if b.State() != BreakerClosed || !b.Allow() {
+		t.Fatalf("state = %s, want closed", b.State())
+	}
+}
+
+func TestBreakerInterceptorFailsFast(t *testing.T) {
+	b := NewBreaker(BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})
+	intercept := breakerInterceptor("127.0.0.1:1", b)
+
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Unavailable, "connection refused")
+	}
+	for i := 0; i < 5; i++ {
+		err := intercept(context.Background(), "/pb.RoomRpcService/JoinRoomRpc", nil, nil, nil, invoker)
+		if status.Code(err) != codes.Unavailable {
+			t.Fatalf("call %d: err = %v", i, err)
+		}
+		if i >= 2 && !IsCircuitOpen(err) {
+			t.Fatalf("call %d: want circuit open error, got %v", i, err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("invoker called %d times, want 2", calls)
+	}
+}
+
+func TestClientPoolSharesConnection(t *testing.T) {
+	pool := NewClientPool(DefaultBreakerConfig)
+	defer pool.Close()
+
+	a, err := pool.Conn("127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := pool.Conn("127.0.0.1:1")
+	c, _ := pool.Conn("127.0.0.1:2")
+	if a != b || a == c {
+		t.Fatal("connections should be shared per address")
+	}
+}
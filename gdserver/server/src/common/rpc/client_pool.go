@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultCallTimeout 调用方没有设置截止时间时使用的超时
+const DefaultCallTimeout = 3 * time.Second
+
+// ErrCircuitOpen 下游实例熔断中，请求未发出
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// retryServiceConfig 重试策略：只重试只读的查询方法（房间列表、房间状态重同步），其他方法一律不重试。
+// UNAVAILABLE 并不保证请求没有被处理（例如对端处理完成后、响应发出前连接断开），
+// 出牌、建房、邀请等非幂等请求重试可能被执行两次，由调用方根据错误码决定如何处理
+const retryServiceConfig = `{
+	"methodConfig": [{
+		"name": [
+			{"service": "room_service.RoomRpcService", "method": "GetRoomListRpc"},
+			{"service": "room_service.RoomRpcService", "method": "ResyncRoomRpc"}
+		],
+		"retryPolicy": {
+			"maxAttempts": 3,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "1s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
+
+// ClientPool 按地址复用的 gRPC 长连接
+// 连接在第一次使用时创建，之后所有调用方共享；每个地址有独立的熔断器。
+type ClientPool struct {
+	mu      sync.Mutex
+	conns   map[string]*pooledConn
+	opts    []grpc.DialOption
+	breaker BreakerConfig
+}
+
+type pooledConn struct {
+	conn    *grpc.ClientConn
+	breaker *Breaker
+}
+
+// NewClientPool opts 追加到默认选项（明文传输、重试策略、默认超时、熔断）之后
+func NewClientPool(breaker BreakerConfig, opts ...grpc.DialOption) *ClientPool {
+	return &ClientPool{
+		conns:   make(map[string]*pooledConn),
+		opts:    opts,
+		breaker: breaker,
+	}
+}
+
+// Conn 获取到 addr 的共享连接，调用方不能关闭
+func (p *ClientPool) Conn(addr string) (*grpc.ClientConn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pc, ok := p.conns[addr]; ok {
+		return pc.conn, nil
+	}
+
+	breaker := NewBreaker(p.breaker)
+	opts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(retryServiceConfig),
+		grpc.WithChainUnaryInterceptor(breakerInterceptor(addr, breaker), deadlineInterceptor),
+	}, p.opts...)
+
+	// NewClient 不阻塞，连接在第一次调用时建立，断开后由 gRPC 自动重连
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	p.conns[addr] = &pooledConn{conn: conn, breaker: breaker}
+	slog.Info("gRPC client connection created", "address", addr)
+	return conn, nil
+}
+
+// BreakerState 地址对应熔断器的状态，没有连接时为 closed
+func (p *ClientPool) BreakerState(addr string) BreakerState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pc, ok := p.conns[addr]; ok {
+		return pc.breaker.State()
+	}
+	return BreakerClosed
+}
+
+// Close 关闭所有连接
+func (p *ClientPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for addr, pc := range p.conns {
+		pc.conn.Close()
+		delete(p.conns, addr)
+	}
+}
+
+// breakerInterceptor 熔断中直接返回 ErrCircuitOpen（UNAVAILABLE），否则按调用结果更新熔断器
+func breakerInterceptor(addr string, breaker *Breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !breaker.Allow() {
+			return status.Error(codes.Unavailable, ErrCircuitOpen.Error()+": "+addr)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		before := breaker.State()
+		breaker.Done(status.Code(err))
+		if after := breaker.State(); after != before {
+			slog.Warn("gRPC circuit breaker state changed", "address", addr, "method", method,
+				"from", before.String(), "to", after.String(), "error", err)
+		}
+		return err
+	}
+}
+
+// deadlineInterceptor 调用方没有设置截止时间时使用 DefaultCallTimeout
+func deadlineInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultCallTimeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// IsCircuitOpen 错误是否是熔断导致的快速失败
+func IsCircuitOpen(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.Unavailable && strings.HasPrefix(s.Message(), ErrCircuitOpen.Error())
+}
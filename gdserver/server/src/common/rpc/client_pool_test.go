@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TestRetryOnlyIdempotentMethods 对端一直返回 UNAVAILABLE 时，只有只读方法会被重试
+func TestRetryOnlyIdempotentMethods(t *testing.T) {
+	var mu sync.Mutex
+	calls := make(map[string]int)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		method, _ := grpc.MethodFromServerStream(stream)
+		mu.Lock()
+		calls[method]++
+		mu.Unlock()
+		return status.Error(codes.Unavailable, "unavailable")
+	}))
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	pool := NewClientPool(BreakerConfig{FailureThreshold: 100, OpenTimeout: time.Second},
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }))
+	defer pool.Close()
+	conn, err := pool.Conn("passthrough:///bufnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		method string
+		want   int
+	}{
+		{"/room_service.RoomRpcService/GetRoomListRpc", 3},
+		{"/room_service.RoomRpcService/ResyncRoomRpc", 3},
+		{"/room_service.RoomRpcService/PlayerActionRpc", 1},
+		{"/room_service.RoomRpcService/CreateRoomRpc", 1},
+		{"/room_service.RoomRpcService/MatchCreateRoomRpc", 1},
+		{"/room_service.RoomRpcService/InviteToRoomRpc", 1},
+	}
+	for _, tt := range tests {
+		err := conn.Invoke(context.Background(), tt.method, &emptypb.Empty{}, &emptypb.Empty{})
+		if status.Code(err) != codes.Unavailable {
+			t.Fatalf("%s: err = %v, want UNAVAILABLE", tt.method, err)
+		}
+		mu.Lock()
+		got := calls[tt.method]
+		mu.Unlock()
+		if got != tt.want {
+			t.Errorf("%s: server saw %d attempts, want %d", tt.method, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Package session 登录服务器颁发的会话
+// 会话保存在 Redis session:{session_id}，刷新令牌保存在 refresh_token:{token}；
+// 撤销会话时删除两者并在 RevokedChannel 上广播，各 Game Server 断开使用该会话的连接
+package session
+
+import (
+	"common/redisutil"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	keyPrefix        = "session:"
+	refreshKeyPrefix = "refresh_token:"
+
+	// RevokedChannel 会话撤销广播频道，消息为 RevokeEvent 的 JSON
+	RevokedChannel = "session_revoked"
+)
+
+// 撤销原因
+const (
+	ReasonAdmin  = "admin"  // 运维撤销
+	ReasonLogout = "logout" // 用户登出
+)
+
+// Key 会话的 Redis 键
+func Key(sessionID string) string {
+	return keyPrefix + sessionID
+}
+
+// RefreshKey 刷新令牌的 Redis 键
+func RefreshKey(refreshToken string) string {
+	return refreshKeyPrefix + refreshToken
+}
+
+// RevokeEvent 会话撤销广播
+type RevokeEvent struct {
+	SessionID string `json:"session_id"`
+	Reason    string `json:"reason"`
+}
+
+// Revoke 删除会话和对应的刷新令牌，并广播给所有 Game Server
+// 会话已不存在时同样广播，确保仍在使用该会话的连接被断开
+func Revoke(rp *redisutil.RedisPool, sessionID, reason string) error {
+	if sessionID == "" {
+		return errors.New("empty session id")
+	}
+
+	var data struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	err := rp.GetJSON(Key(sessionID), &data)
+	if err != nil && !errors.Is(err, redisutil.ErrKeyNotFound) {
+		return err
+	}
+	if data.RefreshToken != "" {
+		if err := rp.Delete(RefreshKey(data.RefreshToken)); err != nil {
+			return err
+		}
+	}
+	if err := rp.Delete(Key(sessionID)); err != nil {
+		return err
+	}
+
+	event, err := json.Marshal(RevokeEvent{SessionID: sessionID, Reason: reason})
+	if err != nil {
+		return err
+	}
+	if err := rp.Publish(RevokedChannel, string(event)); err != nil {
+		return fmt.Errorf("publish session revocation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert 生成自签名证书写入文件，修改时间设为 modTime
+func writeCert(t *testing.T, certFile, keyFile, commonName string, modTime time.Time) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{certFile, keyFile} {
+		if err := os.Chtimes(name, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func commonName(t *testing.T, r *CertReloader) string {
+	t.Helper()
+	cert, err := r.GetCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func TestCertReloaderReloadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	start := time.Now().Add(-time.Minute)
+	writeCert(t, certFile, keyFile, "old", start)
+
+	r, err := NewCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err := r.reloadIfChanged(); reloaded || err != nil {
+		t.Fatalf("reloadIfChanged() = %v, %v without changes", reloaded, err)
+	}
+
+	writeCert(t, certFile, keyFile, "new", start.Add(time.Second))
+	if reloaded, err := r.reloadIfChanged(); !reloaded || err != nil {
+		t.Fatalf("reloadIfChanged() = %v, %v after update", reloaded, err)
+	}
+	if got := commonName(t, r); got != "new" {
+		t.Fatalf("certificate = %q, want new", got)
+	}
+
+	// 私钥与证书不匹配时保留之前的证书
+	writeCert(t, certFile, filepath.Join(dir, "other.pem"), "mismatch", start.Add(2*time.Second))
+	if _, err := r.reloadIfChanged(); err == nil {
+		t.Fatal("expected error for mismatched key")
+	}
+	if got := commonName(t, r); got != "new" {
+		t.Fatalf("certificate = %q after failed reload, want new", got)
+	}
+}
+
+func TestNewCertReloaderMissingFile(t *testing.T) {
+	if _, err := NewCertReloader("missing.pem", "missing.key"); err == nil {
+		t.Fatal("expected error for missing files")
+	}
+}
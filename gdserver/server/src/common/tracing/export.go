@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// defaultOTLPEndpoint 未设置 OTEL_EXPORTER_OTLP_ENDPOINT 时使用的本地 collector
+const defaultOTLPEndpoint = "http://localhost:4318/v1/traces"
+
+// Init 按环境变量初始化追踪，返回的函数在退出前调用以发送剩余的 span：
+//
+//	TRACE_EXPORTER               stdout | otlp，为空时不启用
+//	OTEL_EXPORTER_OTLP_ENDPOINT  OTLP/HTTP 地址，默认 http://localhost:4318（由 otlptracehttp 读取）
+//	OTEL_SERVICE_NAME            覆盖服务名
+//	TRACE_SAMPLE_RATIO           新调用链的采样比例（0-1），默认 1
+func Init(service string) func(context.Context) {
+	if name := os.Getenv("OTEL_SERVICE_NAME"); name != "" {
+		service = name
+	}
+
+	var exporter sdktrace.SpanExporter
+	switch kind := strings.ToLower(os.Getenv("TRACE_EXPORTER")); kind {
+	case "", "none", "off":
+		return func(context.Context) {}
+	case "stdout":
+		exporter = StdoutExporter{}
+	case "otlp":
+		var opts []otlptracehttp.Option
+		if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(defaultOTLPEndpoint))
+		}
+		otlp, err := otlptracehttp.New(context.Background(), opts...)
+		if err != nil {
+			slog.Warn("Failed to create OTLP exporter, tracing disabled", "error", err)
+			return func(context.Context) {}
+		}
+		exporter = otlp
+	default:
+		slog.Warn("Unknown TRACE_EXPORTER, tracing disabled", "value", kind)
+		return func(context.Context) {}
+	}
+
+	ratio := 1.0
+	if v := os.Getenv("TRACE_SAMPLE_RATIO"); v != "" {
+		if r, err := strconv.ParseFloat(v, 64); err == nil && r >= 0 && r <= 1 {
+			ratio = r
+		} else {
+			slog.Warn("Invalid TRACE_SAMPLE_RATIO, using 1", "value", v)
+		}
+	}
+
+	stop := Setup(service, exporter, ratio)
+	slog.Info("Tracing enabled", "service", service, "exporter", os.Getenv("TRACE_EXPORTER"), "sample_ratio", ratio)
+	return stop
+}
+
+// Setup 使用指定导出器启用追踪（测试或自定义导出器使用），返回停止函数
+// 新调用链按 ratio 采样，有上游追踪上下文时沿用上游的采样决定
+func Setup(service string, exporter sdktrace.SpanExporter, ratio float64) func(context.Context) {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+	)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) {
+		if err := provider.Shutdown(ctx); err != nil {
+			slog.Warn("Failed to flush spans", "error", err)
+		}
+		otel.SetTracerProvider(previous)
+	}
+}
+
+// StdoutExporter 以日志的形式输出 span，与服务日志在同一个 JSON 流中，可按 trace_id 检索
+type StdoutExporter struct{}
+
+func (StdoutExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	for _, s := range spans {
+		sc := s.SpanContext()
+		args := []any{
+			"service", serviceName(s.Resource()),
+			"name", s.Name(),
+			"trace_id", sc.TraceID().String(),
+			"span_id", sc.SpanID().String(),
+			"start", s.StartTime(),
+			"duration_ms", float64(s.EndTime().Sub(s.StartTime()).Microseconds()) / 1000,
+		}
+		if parent := s.Parent(); parent.IsValid() {
+			args = append(args, "parent_span_id", parent.SpanID().String())
+		}
+		if status := s.Status(); status.Code == codes.Error {
+			args = append(args, "error", status.Description)
+		}
+		for _, attr := range s.Attributes() {
+			args = append(args, "attr."+string(attr.Key), attr.Value.Emit())
+		}
+		slog.InfoContext(ctx, "trace span", args...)
+	}
+	return nil
+}
+
+func (StdoutExporter) Shutdown(context.Context) error { return nil }
+
+func serviceName(res *resource.Resource) string {
+	if res == nil {
+		return ""
+	}
+	value, _ := res.Set().Value("service.name")
+	return value.AsString()
+}
@@ -0,0 +1,17 @@
+package tracing
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+// ServerOption gRPC 服务端插桩：从 metadata 取出追踪上下文并为每个调用创建 span
+// 流式调用（如通知流）的 span 覆盖整个流，每条通知自行携带 traceparent
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler())
+}
+
+// DialOption gRPC 客户端插桩：为每个调用创建 span 并把追踪上下文写入 metadata
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+)
+
+// logHandler 为带 ctx 的日志（slog.InfoContext 等）添加 trace_id 和 span_id
+type logHandler struct {
+	slog.Handler
+}
+
+// NewLogHandler 包装日志处理器，使用 slog.XxxContext 输出的日志可以按 trace_id 与 span 关联
+func NewLogHandler(h slog.Handler) slog.Handler {
+	return logHandler{Handler: h}
+}
+
+func (h logHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return logHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h logHandler) WithGroup(name string) slog.Handler {
+	return logHandler{Handler: h.Handler.WithGroup(name)}
+}
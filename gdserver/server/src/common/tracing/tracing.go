@@ -0,0 +1,114 @@
+// Package tracing 跨服务的调用链追踪，基于 OpenTelemetry
+//
+// 追踪上下文使用 W3C traceparent 格式，通过 gRPC metadata（otelgrpc）和战斗服通知中的 traceparent 字段传递，
+// 可以导出到标准输出（与日志在同一个流中）或 OTLP collector（OTLP/HTTP）。
+//
+// 每个服务在 main 中调用一次 Init，未配置导出器时 span 不记录也不导出，只透传上游的追踪上下文：
+//
+//	defer tracing.Init("game")(context.Background())
+//
+//	ctx, span := tracing.Start(ctx, "game.JOIN_ROOM_REQUEST", tracing.Uint64("player_id", uid))
+//	defer span.End()
+package tracing
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceparentKey W3C 追踪上下文在 gRPC metadata 和通知中的键
+const TraceparentKey = "traceparent"
+
+// instrumentationName 本包创建的 span 的 instrumentation scope
+const instrumentationName = "common/tracing"
+
+// propagator 未启用导出时也需要透传上游的追踪上下文，因此在包初始化时设置
+var propagator = propagation.TraceContext{}
+
+func init() {
+	otel.SetTextMapPropagator(propagator)
+}
+
+// SpanKind span 类型
+type SpanKind = trace.SpanKind
+
+const (
+	KindInternal = trace.SpanKindInternal
+	KindServer   = trace.SpanKindServer
+	KindClient   = trace.SpanKindClient
+	KindProducer = trace.SpanKindProducer
+	KindConsumer = trace.SpanKindConsumer
+)
+
+// Attr span 属性
+type Attr = attribute.KeyValue
+
+func String(key, value string) Attr      { return attribute.String(key, value) }
+func Int(key string, value int) Attr     { return attribute.Int(key, value) }
+func Int64(key string, value int64) Attr { return attribute.Int64(key, value) }
+func Uint64(key string, value uint64) Attr {
+	return attribute.String(key, strconv.FormatUint(value, 10))
+}
+func Bool(key string, value bool) Attr { return attribute.Bool(key, value) }
+
+// Span 调用链中的一个环节，未启用追踪时是不记录的 span，方法都可以直接调用
+type Span struct {
+	trace.Span
+}
+
+// SetError 标记 span 失败，err 为 nil 时忽略
+func (s Span) SetError(err error) {
+	if err == nil {
+		return
+	}
+	s.RecordError(err)
+	s.SetStatus(codes.Error, err.Error())
+}
+
+// SetErrorMessage 标记 span 失败（业务错误码等）
+func (s Span) SetErrorMessage(msg string) {
+	s.SetStatus(codes.Error, msg)
+}
+
+// ContextWithTraceparent 解析 traceparent 并放入 ctx，之后创建的 span 以它为父节点，为空或格式不正确时返回原 ctx
+func ContextWithTraceparent(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{TraceparentKey: traceparent})
+}
+
+// Traceparent ctx 中追踪上下文的 traceparent 编码，没有时返回空字符串
+func Traceparent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get(TraceparentKey)
+}
+
+// SpanContextFromContext 当前的追踪上下文（本进程的 span 或上游传来的）
+func SpanContextFromContext(ctx context.Context) trace.SpanContext {
+	return trace.SpanContextFromContext(ctx)
+}
+
+// SpanFromContext ctx 中当前的 span，没有时返回不记录的 span
+func SpanFromContext(ctx context.Context) Span {
+	return Span{Span: trace.SpanFromContext(ctx)}
+}
+
+// Start 创建内部 span
+func Start(ctx context.Context, name string, attrs ...Attr) (context.Context, Span) {
+	return StartWithKind(ctx, name, KindInternal, attrs...)
+}
+
+// StartWithKind 创建指定类型的 span，ctx 中有追踪上下文时作为子节点，否则开始新的调用链
+func StartWithKind(ctx context.Context, name string, kind SpanKind, attrs ...Attr) (context.Context, Span) {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, name,
+		trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+	return ctx, Span{Span: span}
+}
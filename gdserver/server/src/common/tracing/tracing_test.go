@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+// keepExporter 停止时保留已导出的 span（InMemoryExporter.Shutdown 会清空）
+type keepExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (keepExporter) Shutdown(context.Context) error { return nil }
+
+func TestTraceparentRoundTrip(t *testing.T) {
+	ctx := ContextWithTraceparent(context.Background(), testTraceparent)
+	if got := Traceparent(ctx); got != testTraceparent {
+		t.Fatalf("round trip = %q", got)
+	}
+
+	for _, bad := range []string{"", "00-abc-def-01", "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-zzf067aa0ba902b7-01"} {
+		ctx := ContextWithTraceparent(context.Background(), bad)
+		if SpanContextFromContext(ctx).IsValid() {
+			t.Errorf("ContextWithTraceparent(%q) should be ignored", bad)
+		}
+	}
+}
+
+func TestDisabledPassesThroughRemoteContext(t *testing.T) {
+	ctx := ContextWithTraceparent(context.Background(), testTraceparent)
+	ctx, span := Start(ctx, "noop")
+	if span.IsRecording() {
+		t.Fatal("span recorded without exporter")
+	}
+	span.SetAttributes(String("k", "v"))
+	span.End()
+	if got := Traceparent(ctx); got != testTraceparent {
+		t.Fatalf("traceparent = %q, want upstream %q", got, testTraceparent)
+	}
+}
+
+// TestGRPCPropagation 客户端插桩写入 metadata，服务端插桩取出后创建子 span
+func TestGRPCPropagation(t *testing.T) {
+	exporter := keepExporter{tracetest.NewInMemoryExporter()}
+	stop := Setup("test", exporter, 1)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(ServerOption())
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		DialOption())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, root := StartWithKind(context.Background(), "game.JOIN_ROOM_REQUEST", KindServer)
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	root.End()
+	srv.Stop()
+	stop(context.Background())
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("exported %d spans, want root, client and server", len(spans))
+	}
+	rootSC := root.SpanContext()
+	var client, server tracetest.SpanStub
+	for _, s := range spans {
+		if s.SpanContext.TraceID() != rootSC.TraceID() {
+			t.Errorf("span %s: trace=%s, want %s", s.Name, s.SpanContext.TraceID(), rootSC.TraceID())
+		}
+		if got := serviceName(s.Resource); got != "test" {
+			t.Errorf("span %s: service=%q", s.Name, got)
+		}
+		if s.Name != "grpc.health.v1.Health/Check" {
+			continue
+		}
+		switch s.SpanKind {
+		case KindClient:
+			client = s
+		case KindServer:
+			server = s
+		}
+	}
+	if client.Parent.SpanID() != rootSC.SpanID() || server.Parent.SpanID() != client.SpanContext.SpanID() {
+		t.Fatalf("unexpected parents: client=%s server=%s", client.Parent.SpanID(), server.Parent.SpanID())
+	}
+}
@@ -235,6 +235,292 @@ func (x *MatchResultNotifyRequest) GetMatchResult() *MatchResultNotify {
 	return nil
 }
 
+// 房间邀请通知
+type RoomInviteNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeNotifiedUid uint64      `protobuf:"varint,1,opt,name=be_notified_uid,json=beNotifiedUid,proto3" json:"be_notified_uid,omitempty"` // 被通知的用户ID
+	Invite        *RoomInvite `protobuf:"bytes,2,opt,name=invite,proto3" json:"invite,omitempty"`
+}
+
+func (x *RoomInviteNotify) Reset() {
+	*x = RoomInviteNotify{}
+	mi := &file_game_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomInviteNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomInviteNotify) ProtoMessage() {}
+
+func (x *RoomInviteNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_game_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomInviteNotify.ProtoReflect.Descriptor instead.
+func (*RoomInviteNotify) Descriptor() ([]byte, []int) {
+	return file_game_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoomInviteNotify) GetBeNotifiedUid() uint64 {
+	if x != nil {
+		return x.BeNotifiedUid
+	}
+	return 0
+}
+
+func (x *RoomInviteNotify) GetInvite() *RoomInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+// 流式通知：战斗服推送给玩家的一条通知
+type PlayerNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeNotifiedUid uint64    `protobuf:"varint,1,opt,name=be_notified_uid,json=beNotifiedUid,proto3" json:"be_notified_uid,omitempty"` // 被通知的用户ID
+	Seq           uint64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`                                            // 通知序号（同一条流内递增，用于回报投递失败）
+	MsgId         MessageId `protobuf:"varint,3,opt,name=msg_id,json=msgId,proto3,enum=game.MessageId" json:"msg_id,omitempty"`       // 推送给客户端的消息ID
+	Data          []byte    `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`                                           // 已序列化的消息体
+	Traceparent   string    `protobuf:"bytes,5,opt,name=traceparent,proto3" json:"traceparent,omitempty"`                             // 产生该通知的调用链（W3C traceparent），没有时为空
+}
+
+func (x *PlayerNotification) Reset() {
+	*x = PlayerNotification{}
+	mi := &file_game_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerNotification) ProtoMessage() {}
+
+func (x *PlayerNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_game_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerNotification.ProtoReflect.Descriptor instead.
+func (*PlayerNotification) Descriptor() ([]byte, []int) {
+	return file_game_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlayerNotification) GetBeNotifiedUid() uint64 {
+	if x != nil {
+		return x.BeNotifiedUid
+	}
+	return 0
+}
+
+func (x *PlayerNotification) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PlayerNotification) GetMsgId() MessageId {
+	if x != nil {
+		return x.MsgId
+	}
+	return MessageId_LOGIN_REQUEST
+}
+
+func (x *PlayerNotification) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PlayerNotification) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+// 流式通知：一批通知（同一玩家的通知按顺序排列）
+type NotificationBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*PlayerNotification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+}
+
+func (x *NotificationBatch) Reset() {
+	*x = NotificationBatch{}
+	mi := &file_game_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationBatch) ProtoMessage() {}
+
+func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_game_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationBatch.ProtoReflect.Descriptor instead.
+func (*NotificationBatch) Descriptor() ([]byte, []int) {
+	return file_game_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NotificationBatch) GetNotifications() []*PlayerNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// 流式通知：投递失败的通知
+type NotificationFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq           uint64    `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	BeNotifiedUid uint64    `protobuf:"varint,2,opt,name=be_notified_uid,json=beNotifiedUid,proto3" json:"be_notified_uid,omitempty"`
+	Ret           ErrorCode `protobuf:"varint,3,opt,name=ret,proto3,enum=game.ErrorCode" json:"ret,omitempty"` // PLAYER_OFFLINE: 玩家不在线；SERVER_BUSY: 玩家发送队列已满
+}
+
+func (x *NotificationFailure) Reset() {
+	*x = NotificationFailure{}
+	mi := &file_game_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationFailure) ProtoMessage() {}
+
+func (x *NotificationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_game_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationFailure.ProtoReflect.Descriptor instead.
+func (*NotificationFailure) Descriptor() ([]byte, []int) {
+	return file_game_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NotificationFailure) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *NotificationFailure) GetBeNotifiedUid() uint64 {
+	if x != nil {
+		return x.BeNotifiedUid
+	}
+	return 0
+}
+
+func (x *NotificationFailure) GetRet() ErrorCode {
+	if x != nil {
+		return x.Ret
+	}
+	return ErrorCode_OK
+}
+
+// 流式通知：游戏服异步回报的投递结果（只包含失败的通知）
+type NotificationAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Failures []*NotificationFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *NotificationAck) Reset() {
+	*x = NotificationAck{}
+	mi := &file_game_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationAck) ProtoMessage() {}
+
+func (x *NotificationAck) ProtoReflect() protoreflect.Message {
+	mi := &file_game_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationAck.ProtoReflect.Descriptor instead.
+func (*NotificationAck) Descriptor() ([]byte, []int) {
+	return file_game_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NotificationAck) GetFailures() []*NotificationFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
 var File_game_service_proto protoreflect.FileDescriptor
 
 var file_game_service_proto_rawDesc = []byte{
@@ -270,39 +556,85 @@ var file_game_service_proto_rawDesc = []byte{
 	0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0xe1, 0x03, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x52, 0x6f, 0x6f,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63,
-	0x12, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x47, 0x61, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x17,
-	0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65,
-	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4b, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x1a, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c,
-	0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x12,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
-	0x70, 0x63, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x61, 0x6d,
-	0x65, 0x45, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x1b, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x45, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x64, 0x0a, 0x10, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65,
+	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x55,
+	0x69, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0xac, 0x01, 0x0a,
+	0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x26, 0x0a,
+	0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x52, 0x05,
+	0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x46, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x03, 0x72, 0x65, 0x74, 0x22, 0x50, 0x0a, 0x0f,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12,
+	0x3d, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x32, 0x84,
+	0x05, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4d, 0x0a, 0x13, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x12, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x17, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a,
+	0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63,
+	0x12, 0x1a, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
+	0x70, 0x63, 0x12, 0x26, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74,
 	0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x26, 0x2e, 0x67, 0x61, 0x6d,
-	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x12, 0x5a, 0x10, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x70, 0x63, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x16, 0x2e, 0x62, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x1f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x1a, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x6b, 0x28, 0x01, 0x30, 0x01, 0x42, 0x12, 0x5a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x73, 0x72, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -317,42 +649,59 @@ func file_game_service_proto_rawDescGZIP() []byte {
 	return file_game_service_proto_rawDescData
 }
 
-var file_game_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_game_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_game_service_proto_goTypes = []any{
 	(*RoomDetailNotify)(nil),         // 0: game_service.RoomDetailNotify
 	(*GameStartNotify)(nil),          // 1: game_service.GameStartNotify
 	(*GameEndNotify)(nil),            // 2: game_service.GameEndNotify
 	(*MatchResultNotifyRequest)(nil), // 3: game_service.MatchResultNotifyRequest
-	(*RoomDetail)(nil),               // 4: game.RoomDetail
-	(*GameStartNotification)(nil),    // 5: game.GameStartNotification
-	(*GameEndNotification)(nil),      // 6: battle.GameEndNotification
-	(*MatchResultNotify)(nil),        // 7: game.MatchResultNotify
-	(*GameStateNotify)(nil),          // 8: battle.GameStateNotify
-	(*PlayerActionNotify)(nil),       // 9: battle.PlayerActionNotify
-	(*NotifyResponse)(nil),           // 10: battle.NotifyResponse
+	(*RoomInviteNotify)(nil),         // 4: game_service.RoomInviteNotify
+	(*PlayerNotification)(nil),       // 5: game_service.PlayerNotification
+	(*NotificationBatch)(nil),        // 6: game_service.NotificationBatch
+	(*NotificationFailure)(nil),      // 7: game_service.NotificationFailure
+	(*NotificationAck)(nil),          // 8: game_service.NotificationAck
+	(*RoomDetail)(nil),               // 9: game.RoomDetail
+	(*GameStartNotification)(nil),    // 10: game.GameStartNotification
+	(*GameEndNotification)(nil),      // 11: battle.GameEndNotification
+	(*MatchResultNotify)(nil),        // 12: game.MatchResultNotify
+	(*RoomInvite)(nil),               // 13: game.RoomInvite
+	(MessageId)(0),                   // 14: game.MessageId
+	(ErrorCode)(0),                   // 15: game.ErrorCode
+	(*GameStateNotify)(nil),          // 16: battle.GameStateNotify
+	(*PlayerActionNotify)(nil),       // 17: battle.PlayerActionNotify
+	(*NotifyResponse)(nil),           // 18: battle.NotifyResponse
 }
 var file_game_service_proto_depIdxs = []int32{
-	4,  // 0: game_service.RoomDetailNotify.room:type_name -> game.RoomDetail
-	5,  // 1: game_service.GameStartNotify.game_start:type_name -> game.GameStartNotification
-	6,  // 2: game_service.GameEndNotify.game_end:type_name -> battle.GameEndNotification
-	7,  // 3: game_service.MatchResultNotifyRequest.match_result:type_name -> game.MatchResultNotify
-	0,  // 4: game_service.GameRpcService.RoomStatusNotifyRpc:input_type -> game_service.RoomDetailNotify
-	8,  // 5: game_service.GameRpcService.GameStateNotifyRpc:input_type -> battle.GameStateNotify
-	9,  // 6: game_service.GameRpcService.PlayerActionNotifyRpc:input_type -> battle.PlayerActionNotify
-	1,  // 7: game_service.GameRpcService.GameStartNotifyRpc:input_type -> game_service.GameStartNotify
-	2,  // 8: game_service.GameRpcService.GameEndNotifyRpc:input_type -> game_service.GameEndNotify
-	3,  // 9: game_service.GameRpcService.MatchResultNotifyRpc:input_type -> game_service.MatchResultNotifyRequest
-	10, // 10: game_service.GameRpcService.RoomStatusNotifyRpc:output_type -> battle.NotifyResponse
-	10, // 11: game_service.GameRpcService.GameStateNotifyRpc:output_type -> battle.NotifyResponse
-	10, // 12: game_service.GameRpcService.PlayerActionNotifyRpc:output_type -> battle.NotifyResponse
-	10, // 13: game_service.GameRpcService.GameStartNotifyRpc:output_type -> battle.NotifyResponse
-	10, // 14: game_service.GameRpcService.GameEndNotifyRpc:output_type -> battle.NotifyResponse
-	10, // 15: game_service.GameRpcService.MatchResultNotifyRpc:output_type -> battle.NotifyResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	9,  // 0: game_service.RoomDetailNotify.room:type_name -> game.RoomDetail
+	10, // 1: game_service.GameStartNotify.game_start:type_name -> game.GameStartNotification
+	11, // 2: game_service.GameEndNotify.game_end:type_name -> battle.GameEndNotification
+	12, // 3: game_service.MatchResultNotifyRequest.match_result:type_name -> game.MatchResultNotify
+	13, // 4: game_service.RoomInviteNotify.invite:type_name -> game.RoomInvite
+	14, // 5: game_service.PlayerNotification.msg_id:type_name -> game.MessageId
+	5,  // 6: game_service.NotificationBatch.notifications:type_name -> game_service.PlayerNotification
+	15, // 7: game_service.NotificationFailure.ret:type_name -> game.ErrorCode
+	7,  // 8: game_service.NotificationAck.failures:type_name -> game_service.NotificationFailure
+	0,  // 9: game_service.GameRpcService.RoomStatusNotifyRpc:input_type -> game_service.RoomDetailNotify
+	16, // 10: game_service.GameRpcService.GameStateNotifyRpc:input_type -> battle.GameStateNotify
+	17, // 11: game_service.GameRpcService.PlayerActionNotifyRpc:input_type -> battle.PlayerActionNotify
+	1,  // 12: game_service.GameRpcService.GameStartNotifyRpc:input_type -> game_service.GameStartNotify
+	2,  // 13: game_service.GameRpcService.GameEndNotifyRpc:input_type -> game_service.GameEndNotify
+	3,  // 14: game_service.GameRpcService.MatchResultNotifyRpc:input_type -> game_service.MatchResultNotifyRequest
+	4,  // 15: game_service.GameRpcService.RoomInviteNotifyRpc:input_type -> game_service.RoomInviteNotify
+	6,  // 16: game_service.GameRpcService.NotifyStream:input_type -> game_service.NotificationBatch
+	18, // 17: game_service.GameRpcService.RoomStatusNotifyRpc:output_type -> battle.NotifyResponse
+	18, // 18: game_service.GameRpcService.GameStateNotifyRpc:output_type -> battle.NotifyResponse
+	18, // 19: game_service.GameRpcService.PlayerActionNotifyRpc:output_type -> battle.NotifyResponse
+	18, // 20: game_service.GameRpcService.GameStartNotifyRpc:output_type -> battle.NotifyResponse
+	18, // 21: game_service.GameRpcService.GameEndNotifyRpc:output_type -> battle.NotifyResponse
+	18, // 22: game_service.GameRpcService.MatchResultNotifyRpc:output_type -> battle.NotifyResponse
+	18, // 23: game_service.GameRpcService.RoomInviteNotifyRpc:output_type -> battle.NotifyResponse
+	8,  // 24: game_service.GameRpcService.NotifyStream:output_type -> game_service.NotificationAck
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_game_service_proto_init() }
@@ -368,7 +717,7 @@ func file_game_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_game_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
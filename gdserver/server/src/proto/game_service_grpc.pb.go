@@ -25,6 +25,8 @@ const (
 	GameRpcService_GameStartNotifyRpc_FullMethodName    = "/game_service.GameRpcService/GameStartNotifyRpc"
 	GameRpcService_GameEndNotifyRpc_FullMethodName      = "/game_service.GameRpcService/GameEndNotifyRpc"
 	GameRpcService_MatchResultNotifyRpc_FullMethodName  = "/game_service.GameRpcService/MatchResultNotifyRpc"
+	GameRpcService_RoomInviteNotifyRpc_FullMethodName   = "/game_service.GameRpcService/RoomInviteNotifyRpc"
+	GameRpcService_NotifyStream_FullMethodName          = "/game_service.GameRpcService/NotifyStream"
 )
 
 // GameRpcServiceClient is the client API for GameRpcService service.
@@ -37,6 +39,9 @@ type GameRpcServiceClient interface {
 	GameStartNotifyRpc(ctx context.Context, in *GameStartNotify, opts ...grpc.CallOption) (*NotifyResponse, error)
 	GameEndNotifyRpc(ctx context.Context, in *GameEndNotify, opts ...grpc.CallOption) (*NotifyResponse, error)
 	MatchResultNotifyRpc(ctx context.Context, in *MatchResultNotifyRequest, opts ...grpc.CallOption) (*NotifyResponse, error)
+	RoomInviteNotifyRpc(ctx context.Context, in *RoomInviteNotify, opts ...grpc.CallOption) (*NotifyResponse, error)
+	// 战斗服到游戏服的长连接通知流：战斗服批量推送，游戏服异步回报投递失败
+	NotifyStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[NotificationBatch, NotificationAck], error)
 }
 
 type gameRpcServiceClient struct {
@@ -107,6 +112,29 @@ func (c *gameRpcServiceClient) MatchResultNotifyRpc(ctx context.Context, in *Mat
 	return out, nil
 }
 
+func (c *gameRpcServiceClient) RoomInviteNotifyRpc(ctx context.Context, in *RoomInviteNotify, opts ...grpc.CallOption) (*NotifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyResponse)
+	err := c.cc.Invoke(ctx, GameRpcService_RoomInviteNotifyRpc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameRpcServiceClient) NotifyStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[NotificationBatch, NotificationAck], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameRpcService_ServiceDesc.Streams[0], GameRpcService_NotifyStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[NotificationBatch, NotificationAck]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameRpcService_NotifyStreamClient = grpc.BidiStreamingClient[NotificationBatch, NotificationAck]
+
 // GameRpcServiceServer is the server API for GameRpcService service.
 // All implementations must embed UnimplementedGameRpcServiceServer
 // for forward compatibility.
@@ -117,6 +145,9 @@ type GameRpcServiceServer interface {
 	GameStartNotifyRpc(context.Context, *GameStartNotify) (*NotifyResponse, error)
 	GameEndNotifyRpc(context.Context, *GameEndNotify) (*NotifyResponse, error)
 	MatchResultNotifyRpc(context.Context, *MatchResultNotifyRequest) (*NotifyResponse, error)
+	RoomInviteNotifyRpc(context.Context, *RoomInviteNotify) (*NotifyResponse, error)
+	// 战斗服到游戏服的长连接通知流：战斗服批量推送，游戏服异步回报投递失败
+	NotifyStream(grpc.BidiStreamingServer[NotificationBatch, NotificationAck]) error
 	mustEmbedUnimplementedGameRpcServiceServer()
 }
 
@@ -145,6 +176,12 @@ func (UnimplementedGameRpcServiceServer) GameEndNotifyRpc(context.Context, *Game
 func (UnimplementedGameRpcServiceServer) MatchResultNotifyRpc(context.Context, *MatchResultNotifyRequest) (*NotifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MatchResultNotifyRpc not implemented")
 }
+func (UnimplementedGameRpcServiceServer) RoomInviteNotifyRpc(context.Context, *RoomInviteNotify) (*NotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoomInviteNotifyRpc not implemented")
+}
+func (UnimplementedGameRpcServiceServer) NotifyStream(grpc.BidiStreamingServer[NotificationBatch, NotificationAck]) error {
+	return status.Errorf(codes.Unimplemented, "method NotifyStream not implemented")
+}
 func (UnimplementedGameRpcServiceServer) mustEmbedUnimplementedGameRpcServiceServer() {}
 func (UnimplementedGameRpcServiceServer) testEmbeddedByValue()                        {}
 
@@ -274,6 +311,31 @@ func _GameRpcService_MatchResultNotifyRpc_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameRpcService_RoomInviteNotifyRpc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomInviteNotify)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameRpcServiceServer).RoomInviteNotifyRpc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameRpcService_RoomInviteNotifyRpc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameRpcServiceServer).RoomInviteNotifyRpc(ctx, req.(*RoomInviteNotify))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameRpcService_NotifyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GameRpcServiceServer).NotifyStream(&grpc.GenericServerStream[NotificationBatch, NotificationAck]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameRpcService_NotifyStreamServer = grpc.BidiStreamingServer[NotificationBatch, NotificationAck]
+
 // GameRpcService_ServiceDesc is the grpc.ServiceDesc for GameRpcService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,7 +367,18 @@ var GameRpcService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MatchResultNotifyRpc",
 			Handler:    _GameRpcService_MatchResultNotifyRpc_Handler,
 		},
+		{
+			MethodName: "RoomInviteNotifyRpc",
+			Handler:    _GameRpcService_RoomInviteNotifyRpc_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "NotifyStream",
+			Handler:       _GameRpcService_NotifyStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "game_service.proto",
 }
@@ -27,6 +27,8 @@ const (
 	RoomRpcService_GetRoomListRpc_FullMethodName     = "/room_service.RoomRpcService/GetRoomListRpc"
 	RoomRpcService_PlayerActionRpc_FullMethodName    = "/room_service.RoomRpcService/PlayerActionRpc"
 	RoomRpcService_MatchCreateRoomRpc_FullMethodName = "/room_service.RoomRpcService/MatchCreateRoomRpc"
+	RoomRpcService_InviteToRoomRpc_FullMethodName    = "/room_service.RoomRpcService/InviteToRoomRpc"
+	RoomRpcService_ResyncRoomRpc_FullMethodName      = "/room_service.RoomRpcService/ResyncRoomRpc"
 )
 
 // RoomRpcServiceClient is the client API for RoomRpcService service.
@@ -41,10 +43,14 @@ type RoomRpcServiceClient interface {
 	// 获取房间列表
 	GetRoomListRpc(ctx context.Context, in *GetRoomListRpcRequest, opts ...grpc.CallOption) (*GetRoomListRpcResponse, error)
 	// 使用流式 RPC 处理玩家操作
-	// rpc PlayerActionRpc(stream PlayerActionRpcRequest) returns (stream PlayerActionRpcResponse);
+	//rpc PlayerActionRpc(stream PlayerActionRpcRequest) returns (stream PlayerActionRpcResponse);
 	PlayerActionRpc(ctx context.Context, in *PlayerActionRpcRequest, opts ...grpc.CallOption) (*PlayerActionRpcResponse, error)
 	// 匹配创建房间
 	MatchCreateRoomRpc(ctx context.Context, in *MatchCreateRoomRpcRequest, opts ...grpc.CallOption) (*MatchCreateRoomRpcResponse, error)
+	// 邀请玩家加入房间（通过 GameRpcService 推送邀请）
+	InviteToRoomRpc(ctx context.Context, in *InviteToRoomRpcRequest, opts ...grpc.CallOption) (*InviteToRoomRpcResponse, error)
+	// 重连后推送房间和游戏的完整状态
+	ResyncRoomRpc(ctx context.Context, in *ResyncRoomRpcRequest, opts ...grpc.CallOption) (*ResyncRoomRpcResponse, error)
 }
 
 type roomRpcServiceClient struct {
@@ -135,6 +141,26 @@ func (c *roomRpcServiceClient) MatchCreateRoomRpc(ctx context.Context, in *Match
 	return out, nil
 }
 
+func (c *roomRpcServiceClient) InviteToRoomRpc(ctx context.Context, in *InviteToRoomRpcRequest, opts ...grpc.CallOption) (*InviteToRoomRpcResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteToRoomRpcResponse)
+	err := c.cc.Invoke(ctx, RoomRpcService_InviteToRoomRpc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomRpcServiceClient) ResyncRoomRpc(ctx context.Context, in *ResyncRoomRpcRequest, opts ...grpc.CallOption) (*ResyncRoomRpcResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResyncRoomRpcResponse)
+	err := c.cc.Invoke(ctx, RoomRpcService_ResyncRoomRpc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoomRpcServiceServer is the server API for RoomRpcService service.
 // All implementations must embed UnimplementedRoomRpcServiceServer
 // for forward compatibility.
@@ -147,10 +173,14 @@ type RoomRpcServiceServer interface {
 	// 获取房间列表
 	GetRoomListRpc(context.Context, *GetRoomListRpcRequest) (*GetRoomListRpcResponse, error)
 	// 使用流式 RPC 处理玩家操作
-	// rpc PlayerActionRpc(stream PlayerActionRpcRequest) returns (stream PlayerActionRpcResponse);
+	//rpc PlayerActionRpc(stream PlayerActionRpcRequest) returns (stream PlayerActionRpcResponse);
 	PlayerActionRpc(context.Context, *PlayerActionRpcRequest) (*PlayerActionRpcResponse, error)
 	// 匹配创建房间
 	MatchCreateRoomRpc(context.Context, *MatchCreateRoomRpcRequest) (*MatchCreateRoomRpcResponse, error)
+	// 邀请玩家加入房间（通过 GameRpcService 推送邀请）
+	InviteToRoomRpc(context.Context, *InviteToRoomRpcRequest) (*InviteToRoomRpcResponse, error)
+	// 重连后推送房间和游戏的完整状态
+	ResyncRoomRpc(context.Context, *ResyncRoomRpcRequest) (*ResyncRoomRpcResponse, error)
 	mustEmbedUnimplementedRoomRpcServiceServer()
 }
 
@@ -185,6 +215,12 @@ func (UnimplementedRoomRpcServiceServer) PlayerActionRpc(context.Context, *Playe
 func (UnimplementedRoomRpcServiceServer) MatchCreateRoomRpc(context.Context, *MatchCreateRoomRpcRequest) (*MatchCreateRoomRpcResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MatchCreateRoomRpc not implemented")
 }
+func (UnimplementedRoomRpcServiceServer) InviteToRoomRpc(context.Context, *InviteToRoomRpcRequest) (*InviteToRoomRpcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToRoomRpc not implemented")
+}
+func (UnimplementedRoomRpcServiceServer) ResyncRoomRpc(context.Context, *ResyncRoomRpcRequest) (*ResyncRoomRpcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResyncRoomRpc not implemented")
+}
 func (UnimplementedRoomRpcServiceServer) mustEmbedUnimplementedRoomRpcServiceServer() {}
 func (UnimplementedRoomRpcServiceServer) testEmbeddedByValue()                        {}
 
@@ -350,6 +386,42 @@ func _RoomRpcService_MatchCreateRoomRpc_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoomRpcService_InviteToRoomRpc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToRoomRpcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomRpcServiceServer).InviteToRoomRpc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomRpcService_InviteToRoomRpc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomRpcServiceServer).InviteToRoomRpc(ctx, req.(*InviteToRoomRpcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomRpcService_ResyncRoomRpc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncRoomRpcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomRpcServiceServer).ResyncRoomRpc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomRpcService_ResyncRoomRpc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomRpcServiceServer).ResyncRoomRpc(ctx, req.(*ResyncRoomRpcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoomRpcService_ServiceDesc is the grpc.ServiceDesc for RoomRpcService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -389,6 +461,14 @@ var RoomRpcService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MatchCreateRoomRpc",
 			Handler:    _RoomRpcService_MatchCreateRoomRpc_Handler,
 		},
+		{
+			MethodName: "InviteToRoomRpc",
+			Handler:    _RoomRpcService_InviteToRoomRpc_Handler,
+		},
+		{
+			MethodName: "ResyncRoomRpc",
+			Handler:    _RoomRpcService_ResyncRoomRpc_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "room_service.proto",
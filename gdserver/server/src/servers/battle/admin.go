@@ -0,0 +1,452 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	pb "proto"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// 运维管理接口（内部 HTTP），用于查看和干预线上房间：
+//
+//	GET  /admin/rooms                  房间列表（玩家、状态、存在时间）
+//	GET  /admin/rooms/{id}             房间详情和完整游戏状态（包含手牌）
+//	POST /admin/rooms/{id}/end         强制结束游戏 {"settle": true}，settle=false 时本局不结算
+//	POST /admin/rooms/{id}/kick        移出玩家 {"player_id": 123}
+//	POST /admin/rooms/{id}/broadcast   向房间广播系统消息 {"message": "..."}
+//
+// 所有请求需要在 X-Internal-Auth 头中携带 BATTLE_ADMIN_TOKEN，未配置令牌时不启动管理接口
+const adminAuthHeader = "X-Internal-Auth"
+
+// adminCommandTimeout 管理命令等待房间循环执行的最长时间
+const adminCommandTimeout = 3 * time.Second
+
+// loadAdminAddr 管理接口监听地址，可通过 BATTLE_ADMIN_ADDR 配置，默认只监听本机 gRPC 端口 + 1000
+func loadAdminAddr(grpcPort int) string {
+	if addr := os.Getenv("BATTLE_ADMIN_ADDR"); addr != "" {
+		return addr
+	}
+	return fmt.Sprintf("127.0.0.1:%d", grpcPort+1000)
+}
+
+// startAdminServer 启动管理接口，未配置 BATTLE_ADMIN_TOKEN 时返回 nil
+func (s *BattleServer) startAdminServer(addr, token string) *http.Server {
+	if token == "" {
+		slog.Warn("BATTLE_ADMIN_TOKEN not set, admin API disabled")
+		return nil
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		slog.Error("Failed to listen for admin API", "addr", addr, "error", err)
+		return nil
+	}
+
+	srv := &http.Server{
+		Handler:           s.adminHandler(token),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Admin API stopped", "error", err)
+		}
+	}()
+	slog.Info("Admin API started", "addr", lis.Addr().String())
+	return srv
+}
+
+// adminHandler 管理接口路由，所有路由都需要校验令牌
+func (s *BattleServer) adminHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/rooms", s.handleAdminListRooms)
+	mux.HandleFunc("GET /admin/rooms/{id}", s.handleAdminDumpRoom)
+	mux.HandleFunc("POST /admin/rooms/{id}/end", s.handleAdminEndGame)
+	mux.HandleFunc("POST /admin/rooms/{id}/kick", s.handleAdminKick)
+	mux.HandleFunc("POST /admin/rooms/{id}/broadcast", s.handleAdminBroadcast)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(adminAuthHeader)), []byte(token)) != 1 {
+			slog.Warn("Admin API unauthorized", "remote", r.RemoteAddr, "path", r.URL.Path)
+			writeAdminError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		slog.Info("Admin API request", "remote", r.RemoteAddr, "method", r.Method, "path", r.URL.Path)
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// AdminRoom 管理接口返回的房间概要
+type AdminRoom struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	GameType      string        `json:"game_type"`
+	State         string        `json:"state"`
+	StateDeadline *time.Time    `json:"state_deadline,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+	Age           string        `json:"age"`
+	InviteCode    string        `json:"invite_code,omitempty"`
+	HasPassword   bool          `json:"has_password"`
+	Players       []AdminPlayer `json:"players"`
+}
+
+type AdminPlayer struct {
+	ID    uint64 `json:"id"`
+	Name  string `json:"name"`
+	Ready bool   `json:"ready"`
+}
+
+// AdminRoomDump 房间详情，GameState 为 protojson 格式的完整游戏状态
+type AdminRoomDump struct {
+	AdminRoom
+	LastActive time.Time       `json:"last_active"`
+	Draining   bool            `json:"draining"`
+	GameState  json.RawMessage `json:"game_state,omitempty"`
+}
+
+// newAdminRoom 根据房间详情构造管理接口的房间概要
+func newAdminRoom(detail *pb.RoomDetail, now time.Time) AdminRoom {
+	info := detail.GetRoom()
+	created := time.UnixMilli(info.GetCreateTime())
+	room := AdminRoom{
+		ID:          info.GetId(),
+		Name:        info.GetName(),
+		GameType:    info.GetGameType(),
+		State:       info.GetStatus().String(),
+		CreatedAt:   created,
+		Age:         now.Sub(created).Round(time.Second).String(),
+		InviteCode:  info.GetInviteCode(),
+		HasPassword: info.GetHasPassword(),
+		Players:     make([]AdminPlayer, 0, len(detail.GetCurrentPlayers())),
+	}
+	if detail.GetStateDeadline() != 0 {
+		deadline := time.UnixMilli(detail.GetStateDeadline())
+		room.StateDeadline = &deadline
+	}
+	for _, p := range detail.GetCurrentPlayers() {
+		room.Players = append(room.Players, AdminPlayer{ID: p.GetUid(), Name: p.GetName(), Ready: p.GetIsReady()})
+	}
+	sort.Slice(room.Players, func(i, j int) bool { return room.Players[i].ID < room.Players[j].ID })
+	return room
+}
+
+func (s *BattleServer) handleAdminListRooms(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	rooms := make([]AdminRoom, 0)
+	for _, room := range s.roomList() {
+		rooms = append(rooms, newAdminRoom(room.GetRoomDetail(), now))
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].CreatedAt.Before(rooms[j].CreatedAt) })
+
+	writeAdminJSON(w, http.StatusOK, map[string]any{
+		"rooms":    rooms,
+		"draining": s.IsDraining(),
+	})
+}
+
+func (s *BattleServer) handleAdminDumpRoom(w http.ResponseWriter, r *http.Request) {
+	room, ok := s.adminRoom(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), adminCommandTimeout)
+	defer cancel()
+	dump, err := room.AdminDump(ctx)
+	if err != nil {
+		writeAdminCommandError(w, err)
+		return
+	}
+	writeAdminJSON(w, http.StatusOK, dump)
+}
+
+func (s *BattleServer) handleAdminEndGame(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Settle *bool `json:"settle"`
+	}
+	if !decodeAdminRequest(w, r, &req) {
+		return
+	}
+	room, ok := s.adminRoom(w, r)
+	if !ok {
+		return
+	}
+
+	// 默认按当前分数结算
+	settle := req.Settle == nil || *req.Settle
+	ctx, cancel := context.WithTimeout(r.Context(), adminCommandTimeout)
+	defer cancel()
+	ret, err := room.AdminEndGame(ctx, settle)
+	slog.Info("Admin force end game", "room_id", room.BattleID, "settle", settle, "ret", ret, "error", err)
+	writeAdminResult(w, ret, err)
+}
+
+func (s *BattleServer) handleAdminKick(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		PlayerID uint64 `json:"player_id"`
+	}
+	if !decodeAdminRequest(w, r, &req) {
+		return
+	}
+	if req.PlayerID == 0 {
+		writeAdminError(w, http.StatusBadRequest, "player_id is required")
+		return
+	}
+	room, ok := s.adminRoom(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), adminCommandTimeout)
+	defer cancel()
+	ret, err := room.AdminKick(ctx, req.PlayerID)
+	slog.Info("Admin kick player", "room_id", room.BattleID, "player_id", req.PlayerID, "ret", ret, "error", err)
+	writeAdminResult(w, ret, err)
+}
+
+func (s *BattleServer) handleAdminBroadcast(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Message string `json:"message"`
+	}
+	if !decodeAdminRequest(w, r, &req) {
+		return
+	}
+	if req.Message == "" {
+		writeAdminError(w, http.StatusBadRequest, "message is required")
+		return
+	}
+	room, ok := s.adminRoom(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), adminCommandTimeout)
+	defer cancel()
+	ret, err := room.AdminBroadcast(ctx, req.Message)
+	slog.Info("Admin broadcast message", "room_id", room.BattleID, "message", req.Message, "ret", ret, "error", err)
+	writeAdminResult(w, ret, err)
+}
+
+// adminRoom 根据路径中的房间ID查找房间，不存在时写入 404
+func (s *BattleServer) adminRoom(w http.ResponseWriter, r *http.Request) (*BattleRoom, bool) {
+	roomID := r.PathValue("id")
+	room, exists := s.getRoom(roomID)
+	if !exists {
+		writeAdminError(w, http.StatusNotFound, "room not found: "+roomID)
+		return nil, false
+	}
+	return room, true
+}
+
+// decodeAdminRequest 解析请求体，空请求体使用默认值
+func decodeAdminRequest(w http.ResponseWriter, r *http.Request, v any) bool {
+	if r.ContentLength == 0 {
+		return true
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(v); err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// writeAdminResult 将房间命令的结果转换为 HTTP 状态码
+func writeAdminResult(w http.ResponseWriter, ret pb.ErrorCode, err error) {
+	if err != nil {
+		writeAdminCommandError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+	switch ret {
+	case pb.ErrorCode_OK:
+	case pb.ErrorCode_NOT_FOUND:
+		status = http.StatusNotFound
+	case pb.ErrorCode_INVALID_STATE:
+		status = http.StatusConflict
+	default:
+		status = http.StatusBadRequest
+	}
+	writeAdminJSON(w, status, map[string]string{"ret": ret.String()})
+}
+
+func writeAdminCommandError(w http.ResponseWriter, err error) {
+	switch commandErrorCode(err) {
+	case pb.ErrorCode_INVALID_ROOM:
+		writeAdminError(w, http.StatusNotFound, err.Error())
+	case pb.ErrorCode_TIMEOUT:
+		writeAdminError(w, http.StatusGatewayTimeout, err.Error())
+	default:
+		writeAdminError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func writeAdminError(w http.ResponseWriter, status int, msg string) {
+	writeAdminJSON(w, status, map[string]string{"error": msg})
+}
+
+func writeAdminJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		slog.Warn("Failed to write admin response", "error", err)
+	}
+}
+
+// ====================== 管理命令 ====================== //
+
+// AdminDumpCommand 导出房间详情和完整游戏状态
+type AdminDumpCommand struct {
+	Reply chan AdminRoomDump
+}
+
+func (cmd *AdminDumpCommand) Execute(room *BattleRoom) {
+	dump := AdminRoomDump{
+		AdminRoom:  newAdminRoom(room.GetRoomDetail(), time.Now()),
+		LastActive: room.lastActive,
+		Draining:   room.draining,
+	}
+	if room.Game != nil {
+		if data, err := protojson.Marshal(room.Game.GetState()); err == nil {
+			dump.GameState = data
+		} else {
+			slog.Warn("Failed to marshal game state", "room_id", room.BattleID, "error", err)
+		}
+	}
+	cmd.Reply <- dump
+}
+
+// AdminDump 导出房间详情和完整游戏状态（包含所有玩家手牌）
+func (room *BattleRoom) AdminDump(ctx context.Context) (AdminRoomDump, error) {
+	reply := make(chan AdminRoomDump, 1)
+	return request(ctx, room, &AdminDumpCommand{Reply: reply}, reply)
+}
+
+// AdminEndGameCommand 强制结束进行中的游戏
+type AdminEndGameCommand struct {
+	Settle bool
+	Reply  chan pb.ErrorCode
+}
+
+func (cmd *AdminEndGameCommand) Execute(room *BattleRoom) {
+	if !room.IsGameStarted() {
+		cmd.Reply <- pb.ErrorCode_INVALID_STATE
+		return
+	}
+
+	if cmd.Settle {
+		// 按当前分数结算并进入结算展示
+		room.EndGame()
+	} else {
+		room.abortGame()
+	}
+	if room.draining {
+		room.closeWithNotice(pb.RoomCloseReason_ROOM_CLOSE_MAINTENANCE)
+	}
+	cmd.Reply <- pb.ErrorCode_OK
+}
+
+// AdminEndGame 强制结束游戏，settle 为 false 时本局不结算直接回到大厅
+func (room *BattleRoom) AdminEndGame(ctx context.Context, settle bool) (pb.ErrorCode, error) {
+	reply := make(chan pb.ErrorCode, 1)
+	return request(ctx, room, &AdminEndGameCommand{Settle: settle, Reply: reply}, reply)
+}
+
+// AdminKickCommand 将玩家移出房间
+type AdminKickCommand struct {
+	PlayerID uint64
+	Reply    chan pb.ErrorCode
+}
+
+func (cmd *AdminKickCommand) Execute(room *BattleRoom) {
+	if _, exists := room.Players[cmd.PlayerID]; !exists {
+		cmd.Reply <- pb.ErrorCode_NOT_FOUND
+		return
+	}
+
+	room.RemovePlayer(cmd.PlayerID)
+	room.notify(cmd.PlayerID, pb.MessageId_KICKED_FROM_ROOM_NOTIFICATION, &pb.KickedFromRoomNotification{
+		RoomId: room.BattleID,
+		Reason: pb.KickReason_KICK_ADMIN,
+	})
+	room.Server.forgetPlayers(room.BattleID, []uint64{cmd.PlayerID})
+
+	room.updateCountdown()
+	room.BroadcastRoomStatus()
+	cmd.Reply <- pb.ErrorCode_OK
+}
+
+// AdminKick 将玩家移出房间并通知该玩家
+func (room *BattleRoom) AdminKick(ctx context.Context, playerID uint64) (pb.ErrorCode, error) {
+	reply := make(chan pb.ErrorCode, 1)
+	return request(ctx, room, &AdminKickCommand{PlayerID: playerID, Reply: reply}, reply)
+}
+
+// AdminBroadcastCommand 向房间内玩家和观战者广播系统消息
+type AdminBroadcastCommand struct {
+	Message string
+	Reply   chan pb.ErrorCode
+}
+
+func (cmd *AdminBroadcastCommand) Execute(room *BattleRoom) {
+	msg := &pb.SystemMessageNotification{
+		RoomId:    room.BattleID,
+		Message:   cmd.Message,
+		Timestamp: time.Now().UnixMilli(),
+	}
+	for playerID := range room.Players {
+		room.notify(playerID, pb.MessageId_SYSTEM_MESSAGE_NOTIFICATION, msg)
+	}
+	cmd.Reply <- pb.ErrorCode_OK
+}
+
+// AdminBroadcast 向房间广播系统消息
+func (room *BattleRoom) AdminBroadcast(ctx context.Context, message string) (pb.ErrorCode, error) {
+	reply := make(chan pb.ErrorCode, 1)
+	return request(ctx, room, &AdminBroadcastCommand{Message: message, Reply: reply}, reply)
+}
+
+// abortGame 中止进行中的游戏，不计胜负直接回到大厅（在房间循环中调用）
+func (room *BattleRoom) abortGame() {
+	slog.Info("Game aborted", "room_id", room.BattleID)
+
+	notification := &pb.GameEndNotification{
+		RoomId:  room.BattleID,
+		Aborted: true,
+	}
+	if room.Game != nil {
+		// 只带分数，不公开其他玩家的手牌
+		for _, p := range room.Game.GetState().GetPlayers() {
+			notification.Players = append(notification.Players, &pb.BattlePlayer{
+				Id:           p.GetId(),
+				Name:         p.GetName(),
+				CurrentScore: p.GetCurrentScore(),
+			})
+		}
+	}
+
+	room.PlayersMutex.Lock()
+	// 不调用 Game.EndGame，避免广播结算结果和累计胜利次数
+	room.Game = nil
+	room.ReadyPlayers = make(map[uint64]bool)
+	room.PlayersMutex.Unlock()
+	room.setState(pb.RoomStatus_ROOM_STATUS_WAITING, time.Time{})
+
+	now := time.Now()
+	for playerID, info := range room.Players {
+		info.idleSince = now
+		room.notify(playerID, pb.MessageId_GAME_END_NOTIFICATION, notification)
+	}
+	room.BroadcastRoomStatus()
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	pb "proto"
+	"strings"
+	"testing"
+)
+
+func TestAdminAPI(t *testing.T) {
+	s := &BattleServer{
+		BattleRooms:  make(map[string]*BattleRoom),
+		PlayerInRoom: make(map[uint64]string),
+	}
+	room := NewBattleRoom("admin-room", s, GameType_WordCardGame)
+	room.Run()
+	defer room.Stop(context.Background())
+	s.BattleRooms[room.BattleID] = room
+	joinPlayers(t, room, 1, 2, 3)
+	for _, id := range []uint64{1, 2, 3} {
+		s.PlayerInRoom[id] = room.BattleID
+	}
+
+	srv := httptest.NewServer(s.adminHandler("secret"))
+	defer srv.Close()
+
+	call := func(method, path, token, body string) (int, map[string]any) {
+		t.Helper()
+		req, _ := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set(adminAuthHeader, token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		var out map[string]any
+		json.NewDecoder(resp.Body).Decode(&out)
+		return resp.StatusCode, out
+	}
+
+	if code, _ := call("GET", "/admin/rooms", "", ""); code != http.StatusUnauthorized {
+		t.Fatalf("no token: status = %d, want 401", code)
+	}
+	if code, _ := call("GET", "/admin/rooms", "wrong", ""); code != http.StatusUnauthorized {
+		t.Fatalf("wrong token: status = %d, want 401", code)
+	}
+
+	code, out := call("GET", "/admin/rooms", "secret", "")
+	rooms, _ := out["rooms"].([]any)
+	if code != http.StatusOK || len(rooms) != 1 {
+		t.Fatalf("list rooms: status=%d body=%v", code, out)
+	}
+	if players := rooms[0].(map[string]any)["players"].([]any); len(players) != 3 {
+		t.Fatalf("listed players = %d, want 3", len(players))
+	}
+
+	// 未开始游戏时不能强制结束
+	if code, _ := call("POST", "/admin/rooms/admin-room/end", "secret", ""); code != http.StatusConflict {
+		t.Fatalf("end before start: status = %d, want 409", code)
+	}
+
+	started := make(chan struct{})
+	room.Submit(context.Background(), roomFunc(func(room *BattleRoom) {
+		room.StartGame()
+		close(started)
+	}))
+	<-started
+
+	// 游戏状态包含所有玩家手牌
+	code, out = call("GET", "/admin/rooms/admin-room", "secret", "")
+	state, _ := out["game_state"].(map[string]any)
+	if code != http.StatusOK || state == nil {
+		t.Fatalf("dump room: status=%d body=%v", code, out)
+	}
+	for _, p := range state["players"].([]any) {
+		if cards, _ := p.(map[string]any)["cards"].([]any); len(cards) == 0 {
+			t.Fatalf("player %v has no cards in dump", p)
+		}
+	}
+
+	if code, _ := call("POST", "/admin/rooms/admin-room/kick", "secret", `{"player_id": 3}`); code != http.StatusOK {
+		t.Fatalf("kick: status = %d, want 200", code)
+	}
+	if code, _ := call("POST", "/admin/rooms/admin-room/kick", "secret", `{"player_id": 3}`); code != http.StatusNotFound {
+		t.Fatalf("kick again: status = %d, want 404", code)
+	}
+	if _, inRoom := s.PlayerInRoom[3]; inRoom {
+		t.Fatal("kicked player still mapped to room")
+	}
+
+	if code, _ := call("POST", "/admin/rooms/admin-room/broadcast", "secret", `{"message": "维护通知"}`); code != http.StatusOK {
+		t.Fatalf("broadcast: status = %d, want 200", code)
+	}
+
+	// 不结算直接回到大厅
+	if code, _ := call("POST", "/admin/rooms/admin-room/end", "secret", `{"settle": false}`); code != http.StatusOK {
+		t.Fatalf("end: status = %d, want 200", code)
+	}
+	if status := room.GetRoomInfo().Status; status != pb.RoomStatus_ROOM_STATUS_WAITING {
+		t.Fatalf("status after abort = %v, want WAITING", status)
+	}
+
+	if code, _ := call("GET", "/admin/rooms/missing", "secret", ""); code != http.StatusNotFound {
+		t.Fatalf("missing room: status = %d, want 404", code)
+	}
+}
@@ -10,14 +10,20 @@ import (
 
 // DesktopPetRoom 桌面宠物房间
 type DesktopPetRoom struct {
-	RoomID      string
-	Players     map[uint64]*DesktopPetPlayer
+	RoomID       string
+	Players      map[uint64]*DesktopPetPlayer
 	PlayersMutex sync.RWMutex
-	CmdChan     chan DesktopPetCommand
-	IsRunning   bool
-	Server      *BattleServer
+	CmdChan      chan DesktopPetCommand
+	IsRunning    bool
+	Server       *BattleServer
+	frame        chan struct{}
+	stopCh       chan struct{}
+	stopOnce     sync.Once
 }
 
+// DesktopPetFrameInterval 桌面宠物房间状态广播间隔（10fps）
+const DesktopPetFrameInterval = 100 * time.Millisecond
+
 // DesktopPetPlayer 桌面宠物玩家
 type DesktopPetPlayer struct {
 	PlayerID   uint64
@@ -45,6 +51,8 @@ func NewDesktopPetRoom(roomID string, server *BattleServer) *DesktopPetRoom {
 		CmdChan:   make(chan DesktopPetCommand, 100),
 		IsRunning: false,
 		Server:    server,
+		frame:     make(chan struct{}, 1),
+		stopCh:    make(chan struct{}),
 	}
 }
 
@@ -76,15 +84,21 @@ func (r *DesktopPetRoom) RemovePlayer(playerID uint64) {
 // Run 运行房间逻辑
 func (r *DesktopPetRoom) Run() {
 	r.IsRunning = true
-	ticker := time.NewTicker(100 * time.Millisecond) // 10fps
 
 	go func() {
-		for r.IsRunning {
+		// 广播帧由共享时间轮驱动，不为每个房间创建 Ticker
+		timer := roomScheduler.Schedule(time.Now().Add(DesktopPetFrameInterval), r.signalFrame)
+		defer func() { timer.Cancel() }()
+
+		for {
 			select {
+			case <-r.stopCh:
+				return
 			case cmd := <-r.CmdChan:
 				r.handleCommand(cmd)
-			case <-ticker.C:
+			case <-r.frame:
 				r.broadcastRoomState()
+				timer = roomScheduler.Schedule(time.Now().Add(DesktopPetFrameInterval), r.signalFrame)
 			}
 		}
 	}()
@@ -95,9 +109,32 @@ func (r *DesktopPetRoom) Run() {
 // Stop 停止房间
 func (r *DesktopPetRoom) Stop() {
 	r.IsRunning = false
+	r.stopOnce.Do(func() { close(r.stopCh) })
 	slog.Info("Desktop pet room stopped", "room_id", r.RoomID)
 }
 
+// signalFrame 时间轮到期回调，通知房间协程广播一帧
+func (r *DesktopPetRoom) signalFrame() {
+	select {
+	case r.frame <- struct{}{}:
+	default:
+	}
+}
+
+// clearLater 一段时间后清除玩家的动作或聊天气泡（内容未被新的命令覆盖时）
+func (r *DesktopPetRoom) clearLater(playerID uint64, after time.Duration, field func(*DesktopPetPlayer) *string, value string) {
+	roomScheduler.Schedule(time.Now().Add(after), func() {
+		// 在时间轮协程外加锁，避免阻塞其他定时器
+		go func() {
+			r.PlayersMutex.Lock()
+			defer r.PlayersMutex.Unlock()
+			if p, exists := r.Players[playerID]; exists && *field(p) == value {
+				*field(p) = ""
+			}
+		}()
+	})
+}
+
 // HandleCommand 处理玩家命令
 func (r *DesktopPetRoom) handleCommand(cmd DesktopPetCommand) {
 	r.PlayersMutex.Lock()
@@ -124,27 +161,13 @@ func (r *DesktopPetRoom) handleCommand(cmd DesktopPetCommand) {
 		if action, ok := cmd.Data.(string); ok {
 			player.Action = action
 			// 动作持续一段时间后自动清除
-			go func() {
-				time.Sleep(1 * time.Second)
-				r.PlayersMutex.Lock()
-				if p, exists := r.Players[cmd.PlayerID]; exists {
-					p.Action = ""
-				}
-				r.PlayersMutex.Unlock()
-			}()
+			r.clearLater(cmd.PlayerID, 1*time.Second, func(p *DesktopPetPlayer) *string { return &p.Action }, action)
 		}
 	case "chat":
 		if chat, ok := cmd.Data.(string); ok {
 			player.ChatText = chat
 			// 聊天消息持续一段时间后自动清除
-			go func() {
-				time.Sleep(3 * time.Second)
-				r.PlayersMutex.Lock()
-				if p, exists := r.Players[cmd.PlayerID]; exists {
-					p.ChatText = ""
-				}
-				r.PlayersMutex.Unlock()
-			}()
+			r.clearLater(cmd.PlayerID, 3*time.Second, func(p *DesktopPetPlayer) *string { return &p.ChatText }, chat)
 		}
 	}
 }
@@ -154,6 +177,11 @@ func (r *DesktopPetRoom) broadcastRoomState() {
 	r.PlayersMutex.RLock()
 	defer r.PlayersMutex.RUnlock()
 
+	// 无人房间不需要广播
+	if len(r.Players) == 0 {
+		return
+	}
+
 	// 构建房间状态
 	roomState := &pb.DesktopPetRoomState{
 		RoomId:  r.RoomID,
@@ -183,4 +211,4 @@ func (r *DesktopPetRoom) notifyPlayer(playerID uint64, state *pb.DesktopPetRoomS
 	// 这里需要实现通过RPC通知玩家的逻辑
 	// 实际实现会根据您的RPC框架有所不同
 	slog.Debug("Notifying player", "player_id", playerID, "room_id", r.RoomID)
-}
\ No newline at end of file
+}
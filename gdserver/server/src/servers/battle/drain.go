@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	pb "proto"
+	"time"
+)
+
+// DrainTimeout 下线时等待进行中游戏结束的最长时间，超时后强制结算，可通过 BATTLE_DRAIN_TIMEOUT 配置
+var DrainTimeout = loadDurationFromEnv("BATTLE_DRAIN_TIMEOUT", 2*time.Minute)
+
+// Drain 进入维护模式：从服务发现注销、拒绝创建房间、通知玩家，
+// 等待进行中的游戏结束，到达截止时间（或 ctx 结束）后强制结算剩余房间
+func (s *BattleServer) Drain(ctx context.Context) {
+	// 在 RoomsMutex 下设置，之后创建房间的请求都会看到维护状态
+	s.RoomsMutex.Lock()
+	s.draining.Store(true)
+	s.RoomsMutex.Unlock()
+
+	deadline := time.Now().Add(DrainTimeout)
+	slog.Info("Battle server draining", "rooms", s.roomCount(), "deadline", deadline)
+
+	s.deregister()
+
+	for _, room := range s.roomList() {
+		roomCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		if err := room.Drain(roomCtx, deadline); err != nil {
+			slog.Warn("Failed to drain room", "room_id", room.BattleID, "error", err)
+		}
+		cancel()
+	}
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+wait:
+	for s.roomCount() > 0 {
+		select {
+		case <-ctx.Done():
+			break wait
+		case <-timer.C:
+			break wait
+		case <-ticker.C:
+			slog.Info("Waiting for games to finish", "rooms", s.roomCount(), "remaining", time.Until(deadline).Round(time.Second))
+		}
+	}
+
+	// 到达截止时间仍未结束的游戏强制结算
+	remaining := s.roomList()
+	for _, room := range remaining {
+		forceCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		if err := room.ForceEnd(forceCtx); err != nil {
+			slog.Warn("Failed to force end room", "room_id", room.BattleID, "error", err)
+		}
+		cancel()
+	}
+
+	slog.Info("Battle server drained", "force_ended", len(remaining))
+}
+
+// IsDraining 是否处于维护模式
+func (s *BattleServer) IsDraining() bool {
+	return s.draining.Load()
+}
+
+// deregister 从服务发现和负载表中移除本实例，GameServer 和 MatchServer 不再选择本实例创建房间
+func (s *BattleServer) deregister() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if s.Discovery != nil && s.InstanceID != "" {
+		if err := s.Discovery.Deregister(ctx, s.InstanceID); err != nil {
+			slog.Error("Failed to deregister service", "instance", s.InstanceID, "error", err)
+		} else {
+			slog.Info("Service deregistered", "instance", s.InstanceID)
+		}
+	}
+	if s.Router != nil && s.InstanceID != "" {
+		if err := s.Router.RemoveLoad(s.InstanceID); err != nil {
+			slog.Warn("Failed to remove battle load", "instance", s.InstanceID, "error", err)
+		}
+	}
+}
+
+func (s *BattleServer) roomList() []*BattleRoom {
+	s.RoomsMutex.RLock()
+	defer s.RoomsMutex.RUnlock()
+
+	rooms := make([]*BattleRoom, 0, len(s.BattleRooms))
+	for _, room := range s.BattleRooms {
+		rooms = append(rooms, room)
+	}
+	return rooms
+}
+
+func (s *BattleServer) roomCount() int {
+	s.RoomsMutex.RLock()
+	defer s.RoomsMutex.RUnlock()
+	return len(s.BattleRooms)
+}
+
+// ====================== 房间维护 ====================== //
+
+// DrainCommand 通知玩家服务器维护，未在游戏中的房间立即关闭，游戏中的房间在游戏结束后关闭
+type DrainCommand struct {
+	Deadline time.Time
+	Reply    chan struct{}
+}
+
+func (cmd *DrainCommand) Execute(room *BattleRoom) {
+	room.startDrain(cmd.Deadline)
+	cmd.Reply <- struct{}{}
+}
+
+// Drain 房间进入维护模式
+func (room *BattleRoom) Drain(ctx context.Context, deadline time.Time) error {
+	reply := make(chan struct{}, 1)
+	_, err := request(ctx, room, &DrainCommand{Deadline: deadline, Reply: reply}, reply)
+	return err
+}
+
+// ForceEndCommand 强制结束进行中的游戏并关闭房间
+type ForceEndCommand struct {
+	Reply chan struct{}
+}
+
+func (cmd *ForceEndCommand) Execute(room *BattleRoom) {
+	if room.IsGameStarted() {
+		slog.Info("Force ending game for maintenance", "room_id", room.BattleID)
+		// 按当前分数结算并广播游戏结束
+		room.EndGame()
+	}
+	room.closeWithNotice(pb.RoomCloseReason_ROOM_CLOSE_MAINTENANCE)
+	cmd.Reply <- struct{}{}
+}
+
+// ForceEnd 强制结算并关闭房间，房间已关闭时直接返回
+func (room *BattleRoom) ForceEnd(ctx context.Context) error {
+	reply := make(chan struct{}, 1)
+	_, err := request(ctx, room, &ForceEndCommand{Reply: reply}, reply)
+	if errors.Is(err, ErrRoomClosed) {
+		return nil
+	}
+	return err
+}
+
+// startDrain 房间进入维护模式（在房间循环中调用）
+func (room *BattleRoom) startDrain(deadline time.Time) {
+	room.draining = true
+
+	for playerID := range room.Players {
+		room.notify(playerID, pb.MessageId_SERVER_MAINTENANCE_NOTIFICATION, &pb.ServerMaintenanceNotification{
+			Deadline: deadline.UnixMilli(),
+		})
+	}
+
+	if !room.IsGameStarted() {
+		room.closeWithNotice(pb.RoomCloseReason_ROOM_CLOSE_MAINTENANCE)
+		return
+	}
+	slog.Info("Room draining, waiting for game to finish", "room_id", room.BattleID, "deadline", deadline)
+}
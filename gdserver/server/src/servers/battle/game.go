@@ -13,6 +13,9 @@ const (
 	WinScore = 5 // 胜利分数
 )
 
+// WordCardDeckFile 词牌配置文件路径（相对战斗服工作目录）
+var WordCardDeckFile = "../cfg/word_cards.json"
+
 // RoomInterface 房间接口，用于Game与Room解耦
 type RoomInterface interface {
 	BroadcastGameState()
@@ -28,6 +31,29 @@ const (
 	// 未来可添加其他游戏类型
 )
 
+// gameTypeNames 游戏类型与对外名称的映射（房间列表按名称过滤）
+var gameTypeNames = map[GameType]string{
+	GameType_WordCardGame: "word_card",
+}
+
+// String 返回游戏类型的对外名称
+func (t GameType) String() string {
+	if name, ok := gameTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseGameType 根据对外名称解析游戏类型
+func ParseGameType(name string) (GameType, bool) {
+	for t, n := range gameTypeNames {
+		if n == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
 // Game 游戏接口
 type Game interface {
 	Init(players []*Player)
@@ -39,7 +65,11 @@ type Game interface {
 	SetRoomRef(room RoomInterface) // 设置房间引用
 
 	Update() bool
+	NextUpdate() time.Time // 下一次需要定时调用 Update 的时间（回合超时、帧率等），零值表示只在玩家操作后调用
 	RemovePlayer(playerID uint64) bool
+
+	Snapshot() ([]byte, error) // 序列化游戏状态，用于房间持久化
+	Restore(data []byte) error // 从快照恢复游戏状态
 }
 
 // Player 玩家结构体
@@ -78,7 +108,7 @@ type WordCardGame struct {
 
 func (g *WordCardGame) Init(players []*Player) {
 	g.Players = players
-	g.Deck = loadDeck("../cfg/word_cards.json", 4)
+	g.Deck = loadDeck(WordCardDeckFile, 4)
 
 	// g.TurnStartTime = time.Now()
 	// g.TurnTimeout = 15 * time.Second
@@ -101,6 +131,46 @@ func (g *WordCardGame) SetRoomRef(room RoomInterface) {
 	g.Room = room
 }
 
+// wordCardGameSnapshot WordCardGame 的持久化数据（不包含房间引用）
+type wordCardGameSnapshot struct {
+	Players     []*Player  `json:"players"`
+	Deck        []GameCard `json:"deck"`
+	Table       []GameCard `json:"table"`
+	POSSeq      []string   `json:"pos_seq"`
+	CurrentTurn int        `json:"current_turn"`
+	LastPlayed  uint64     `json:"last_played"`
+	SkipCount   int        `json:"skip_count"`
+}
+
+// Snapshot 序列化游戏状态
+func (g *WordCardGame) Snapshot() ([]byte, error) {
+	return json.Marshal(&wordCardGameSnapshot{
+		Players:     g.Players,
+		Deck:        g.Deck,
+		Table:       g.Table,
+		POSSeq:      g.POSSeq,
+		CurrentTurn: g.CurrentTurn,
+		LastPlayed:  g.LastPlayed,
+		SkipCount:   g.SkipCount,
+	})
+}
+
+// Restore 从快照恢复游戏状态，房间引用需由调用方通过 SetRoomRef 设置
+func (g *WordCardGame) Restore(data []byte) error {
+	var snapshot wordCardGameSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return err
+	}
+	g.Players = snapshot.Players
+	g.Deck = snapshot.Deck
+	g.Table = snapshot.Table
+	g.POSSeq = snapshot.POSSeq
+	g.CurrentTurn = snapshot.CurrentTurn
+	g.LastPlayed = snapshot.LastPlayed
+	g.SkipCount = snapshot.SkipCount
+	return nil
+}
+
 func (g *WordCardGame) HandleAction(playerID uint64, action *pb.GameAction) pb.ErrorCode {
 	// 添加接收action的日志
 	log.Printf("[Battle] HandleAction - PlayerID: %d, ActionType: %v", playerID, action.ActionType)
@@ -381,6 +451,11 @@ func (g *WordCardGame) RemovePlayer(playerID uint64) bool {
 	return true
 }
 
+// NextUpdate 回合超时暂未启用，游戏状态只随玩家操作变化，不需要定时更新
+func (g *WordCardGame) NextUpdate() time.Time {
+	return time.Time{}
+}
+
 // CheckTurnTimeout 检查并处理回合超时
 func (g *WordCardGame) CheckTurnTimeout() bool {
 	// 检查是否超时（15秒）
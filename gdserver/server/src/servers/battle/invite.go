@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	pb "proto"
+	"time"
+)
+
+const (
+	RoomInviteCodeTTL = 2 * time.Hour // 邀请码有效期，发送邀请时会续期
+)
+
+// assignInviteCode 为房间分配邀请码，分配失败不影响房间创建（只是无法通过邀请码加入）
+func (s *BattleServer) assignInviteCode(room *BattleRoom) {
+	if s.RedisPool == nil {
+		return
+	}
+	code, err := s.RedisPool.GenerateRoomInviteCode(room.BattleID, RoomInviteCodeTTL)
+	if err != nil {
+		slog.Error("Failed to generate room invite code", "room_id", room.BattleID, "error", err)
+		return
+	}
+	room.PlayersMutex.Lock()
+	room.InviteCode = code
+	room.PlayersMutex.Unlock()
+	slog.Info("Room invite code assigned", "room_id", room.BattleID, "invite_code", code)
+}
+
+// releaseInviteCode 房间关闭时删除邀请码，避免邀请码指向已销毁的房间
+func (room *BattleRoom) releaseInviteCode() {
+	if room.InviteCode == "" || room.Server == nil || room.Server.RedisPool == nil {
+		return
+	}
+	if err := room.Server.RedisPool.DeleteRoomInviteCode(room.InviteCode); err != nil {
+		slog.Warn("Failed to delete room invite code", "room_id", room.BattleID, "invite_code", room.InviteCode, "error", err)
+	}
+	room.PlayersMutex.Lock()
+	room.InviteCode = ""
+	room.PlayersMutex.Unlock()
+}
+
+// InviteToRoomRpc 邀请玩家加入房间，通过 GameServer 推送邀请通知
+func (s *BattleServer) InviteToRoomRpc(ctx context.Context, req *pb.InviteToRoomRpcRequest) (*pb.InviteToRoomRpcResponse, error) {
+	slog.Info("InviteToRoomRpc called", "room_id", req.RoomId, "inviter", req.InviterId, "invitee", req.InviteeId)
+
+	if req.InviteeId == 0 || req.InviteeId == req.InviterId {
+		return &pb.InviteToRoomRpcResponse{Ret: pb.ErrorCode_INVALID_PARAM}, nil
+	}
+
+	s.RoomsMutex.RLock()
+	room, exists := s.BattleRooms[req.RoomId]
+	s.RoomsMutex.RUnlock()
+
+	if !exists {
+		return &pb.InviteToRoomRpcResponse{Ret: pb.ErrorCode_INVALID_ROOM}, nil
+	}
+
+	s.PlayersMutex.RLock()
+	_, inviteeInRoom := s.PlayerInRoom[req.InviteeId]
+	s.PlayersMutex.RUnlock()
+	if inviteeInRoom {
+		return &pb.InviteToRoomRpcResponse{Ret: pb.ErrorCode_PLAYER_ALREADY_IN_ROOM}, nil
+	}
+
+	// 在房间循环中校验邀请者并准备邀请码
+	result, err := room.PrepareInvite(ctx, req.InviterId)
+	if err != nil {
+		return &pb.InviteToRoomRpcResponse{Ret: commandErrorCode(err)}, nil
+	}
+	if result.Ret != pb.ErrorCode_OK {
+		return &pb.InviteToRoomRpcResponse{Ret: result.Ret}, nil
+	}
+
+	client, err := s.getGameClient()
+	if err != nil {
+		slog.Error("Failed to get game client for room invite", "error", err)
+		return &pb.InviteToRoomRpcResponse{Ret: pb.ErrorCode_SERVER_ERROR}, nil
+	}
+
+	notifyCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	resp, err := client.RoomInviteNotifyRpc(notifyCtx, &pb.RoomInviteNotify{
+		BeNotifiedUid: req.InviteeId,
+		Invite: &pb.RoomInvite{
+			Room:        result.Room,
+			InviteCode:  result.InviteCode,
+			InviterUid:  req.InviterId,
+			InviterName: req.InviterName,
+		},
+	})
+	if err != nil {
+		slog.Error("Failed to send room invite notification", "invitee", req.InviteeId, "error", err)
+		return &pb.InviteToRoomRpcResponse{Ret: pb.ErrorCode_SERVER_ERROR}, nil
+	}
+	if resp.Ret == int32(pb.ErrorCode_NOT_FOUND) {
+		slog.Info("Invitee is offline", "invitee", req.InviteeId)
+		return &pb.InviteToRoomRpcResponse{Ret: pb.ErrorCode_PLAYER_OFFLINE, InviteCode: result.InviteCode}, nil
+	}
+
+	slog.Info("Room invite sent", "room_id", req.RoomId, "inviter", req.InviterId, "invitee", req.InviteeId)
+
+	return &pb.InviteToRoomRpcResponse{Ret: pb.ErrorCode_OK, InviteCode: result.InviteCode}, nil
+}
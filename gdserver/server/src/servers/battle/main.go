@@ -2,8 +2,10 @@ package main
 
 import (
 	"common/discovery"
+	"common/metrics"
 	"common/redisutil"
 	"common/rpc"
+	"common/tracing"
 	"context"
 	"fmt"
 	"log/slog"
@@ -12,6 +14,7 @@ import (
 	"os/signal"
 	pb "proto"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -30,6 +33,12 @@ type BattleServer struct {
 	PlayersMutex sync.RWMutex
 	Discovery    discovery.Discovery
 	InstanceID   string
+	ServerID     string // 稳定的服务器标识，用于重启后恢复本实例拥有的房间
+	GRPCPort     int    // gRPC监听端口
+	GRPCAddr     string // 对外公布的gRPC地址
+	Router       *discovery.BattleRouter
+	Notifier     *GameNotifier // 到GameServer的通知流
+	draining     atomic.Bool   // 维护模式：拒绝创建房间，等待进行中的游戏结束
 	// GRPC连接管理（全局共享）
 	gameConn   *grpc.ClientConn
 	gameClient pb.GameRpcServiceClient
@@ -38,29 +47,51 @@ type BattleServer struct {
 
 func main() {
 	// 初始化日志
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	logger := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelDebug,
-	}))
+	})))
 	slog.SetDefault(logger)
 	slog.Info("Starting Battle Server...")
 
+	// 初始化调用链追踪（TRACE_EXPORTER 为空时不导出）
+	stopTracing := tracing.Init("battle")
+
 	// 初始化Redis连接池
 	redisConfig := redisutil.LoadRedisConfigFromEnv()
 	redisPool := redisutil.NewRedisPoolFromConfig(redisConfig)
 	defer redisPool.Close()
 
 	// 创建BattleServer实例
+	grpcPort := loadBattleGRPCPort()
 	server := &BattleServer{
 		RedisPool:    redisPool,
 		BattleRooms:  make(map[string]*BattleRoom),
 		PlayerInRoom: make(map[uint64]string),
+		GRPCPort:     grpcPort,
+		GRPCAddr:     loadAdvertiseAddress(grpcPort),
+		Router:       discovery.NewBattleRouter(redisPool, "prod_"),
 	}
+	server.ServerID = loadBattleServerID(grpcPort)
+
+	// 启动指标服务
+	server.registerMetrics()
+	metrics.Serve(fmt.Sprintf(":%d", MetricsPort))
+
+	// 启动到GameServer的通知流
+	server.Notifier = NewGameNotifier(server.getGameClient)
+	server.Notifier.Start()
+
+	// 从Redis恢复重启前的房间和游戏
+	server.RestoreRooms()
 
 	// 注册服务发现
 	server.registerServiceDiscovery()
 
 	// 启动gRPC服务器
-	server.startRoomGRPCServer(rpc.RoomServiceGRPCPort, server.InstanceID)
+	grpcServer := server.startRoomGRPCServer(server.GRPCPort, server.InstanceID)
+
+	// 启动运维管理接口
+	adminServer := server.startAdminServer(loadAdminAddr(server.GRPCPort), os.Getenv("BATTLE_ADMIN_TOKEN"))
 
 	slog.Info("Battle server is running")
 
@@ -71,9 +102,36 @@ func main() {
 	<-c // 等待关闭信号
 	slog.Info("Shutting down Battle server...")
 
-	// 关闭GameServer连接
+	// 维护模式：等待进行中的游戏结束，再次收到信号时立即强制结算
+	drainCtx, cancelDrain := context.WithCancel(context.Background())
+	go func() {
+		<-c
+		slog.Warn("Second shutdown signal received, force ending games")
+		cancelDrain()
+	}()
+	server.Drain(drainCtx)
+	cancelDrain()
+
+	grpcServer.GracefulStop()
+
+	// 停止所有房间循环
+	server.stopRooms()
+
+	if adminServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		adminServer.Shutdown(ctx)
+		cancel()
+	}
+
+	// 发送剩余通知后关闭通知流和GameServer连接
+	server.Notifier.Flush(3 * time.Second)
+	server.Notifier.Stop()
 	server.CloseGameServerConnection()
 
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	stopTracing(ctx)
+	cancel()
+
 	slog.Info("Battle server stopped")
 }
 
@@ -81,18 +139,10 @@ func main() {
 func (s *BattleServer) registerServiceDiscovery() {
 	s.InstanceID = generateInstanceID()
 	disc := discovery.NewRedisDiscovery(s.RedisPool, "prod_")
-
-	// 获取本机IP
-	hostIP, err := getLocalIP()
-	if err != nil {
-		slog.Error("Failed to get local IP", "error", err)
-		hostIP = "127.0.0.1"
-	}
-
-	grpcAddr := fmt.Sprintf("%s:%d", hostIP, rpc.RoomServiceGRPCPort)
+	grpcAddr := s.GRPCAddr
 
 	instance := &discovery.ServiceInstance{
-		ServiceName: "battle-server",
+		ServiceName: discovery.BattleServiceName,
 		InstanceID:  s.InstanceID,
 		Address:     grpcAddr,
 		Metadata: map[string]string{
@@ -110,6 +160,7 @@ func (s *BattleServer) registerServiceDiscovery() {
 	slog.Info("Service registered", "instance", s.InstanceID, "address", grpcAddr)
 
 	s.Discovery = disc
+	s.reportLoad()
 
 	// 心跳协程
 	go func() {
@@ -117,13 +168,20 @@ func (s *BattleServer) registerServiceDiscovery() {
 		defer ticker.Stop()
 
 		for range ticker.C {
+			if s.IsDraining() {
+				// 维护模式下已注销，不再续期
+				return
+			}
 			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-			if err := disc.Heartbeat(ctx, s.InstanceID); err != nil {
+			// 重新注册以刷新实例数据的过期时间（Heartbeat 只更新心跳集合）
+			if err := disc.Register(ctx, instance); err != nil {
 				slog.Error("Heartbeat failed", "error", err)
 			} else {
 				slog.Debug("Heartbeat sent")
 			}
 			cancel()
+			s.reportLoad()
+			s.logStats()
 		}
 	}()
 }
@@ -156,7 +214,8 @@ func (s *BattleServer) getGameClient() (pb.GameRpcServiceClient, error) {
 
 	// 建立新连接
 	gameServerAddr := fmt.Sprintf("127.0.0.1:%d", rpc.GameServiceGRPCPort)
-	conn, err := grpc.NewClient(gameServerAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(gameServerAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+		tracing.DialOption())
 	if err != nil {
 		slog.Error("Failed to create game server connection", "addr", gameServerAddr, "error", err)
 		return nil, fmt.Errorf("failed to connect to game server: %w", err)
@@ -182,106 +241,163 @@ func (s *BattleServer) CloseGameServerConnection() {
 	}
 }
 
-// 启动gRPC服务器
-func (s *BattleServer) startRoomGRPCServer(port int, instanceID string) {
+// 启动gRPC服务器，在后台协程中处理请求
+func (s *BattleServer) startRoomGRPCServer(port int, instanceID string) *grpc.Server {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		slog.Error("Failed to listen", "port", port, "error", err)
 		os.Exit(1)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(tracing.ServerOption())
 	pb.RegisterRoomRpcServiceServer(grpcServer, s)
-	slog.Info("gRPC server starting", "port", port)
-	if err := grpcServer.Serve(lis); err != nil {
-		slog.Error("gRPC server failed", "error", err)
-		os.Exit(1)
-	}
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			slog.Error("gRPC server failed", "error", err)
+			os.Exit(1)
+		}
+	}()
 	slog.Info("gRPC server started", "port", port, "instance_id", instanceID)
+	return grpcServer
 }
 
 func (s *BattleServer) CreateRoomRpc(ctx context.Context, req *pb.CreateRoomRpcRequest) (*pb.CreateRoomRpcResponse, error) {
+	playerIDs := []uint64{req.Player.PlayerId}
+	if ret := s.checkRoomCreation(playerIDs); ret != pb.ErrorCode_OK {
+		return &pb.CreateRoomRpcResponse{Ret: ret}, nil
+	}
 
-	s.RoomsMutex.Lock()
-	defer s.RoomsMutex.Unlock()
-
-	s.PlayersMutex.Lock()
-	defer s.PlayersMutex.Unlock()
-
-	//如果玩家已经在房间中，返回错误
-	if roomID, exists := s.PlayerInRoom[req.Player.PlayerId]; exists {
-		slog.Warn("Player already in room", "player_id", req.Player.PlayerId, "room_id", roomID)
-		return &pb.CreateRoomRpcResponse{Ret: pb.ErrorCode_PLAYER_ALREADY_IN_ROOM}, nil
+	gameType := GameType_WordCardGame
+	if req.GameType != "" {
+		parsed, ok := ParseGameType(req.GameType)
+		if !ok {
+			slog.Warn("Unsupported game type", "player_id", req.Player.PlayerId, "game_type", req.GameType)
+			return &pb.CreateRoomRpcResponse{Ret: pb.ErrorCode_NOT_SUPPORTED}, nil
+		}
+		gameType = parsed
 	}
 
-	roomID, _ := s.RedisPool.GenerateBattleID()
+	roomID, err := s.RedisPool.GenerateBattleID()
+	if err != nil {
+		slog.Error("Failed to generate battle ID", "player_id", req.Player.PlayerId, "error", err)
+		return &pb.CreateRoomRpcResponse{Ret: pb.ErrorCode_SERVER_ERROR}, nil
+	}
 
 	// 创建新战斗房间
-	room := NewBattleRoom(roomID, s, GameType_WordCardGame)
+	room := NewBattleRoom(roomID, s, gameType)
+	if req.Name != "" {
+		room.Name = req.Name
+	}
+	room.Password = req.Password
 	room.AddPlayer(req.Player.PlayerId, req.Player.PlayerName)
-	room.Run()
+	s.prepareRoom(room)
 
-	s.BattleRooms[roomID] = room
-	s.PlayerInRoom[req.Player.PlayerId] = roomID
-
-	slog.Info("Battle room created", "room_id", roomID)
+	if ret := s.publishRoom(room, playerIDs); ret != pb.ErrorCode_OK {
+		s.discardRoom(room)
+		return &pb.CreateRoomRpcResponse{Ret: ret}, nil
+	}
 
-	//todo: room 跟哪个 BattleServer 关联 需要写入到redis里，后续 GameServer收到加入房间请求时可以通过BattleServer的实例ID找到对应的BattleServer
+	slog.Info("Battle room created", "room_id", roomID, "game_type", gameType.String(), "has_password", room.Password != "")
 
 	// 返回RoomDetail而不是RoomId
-	roomDetail := &pb.RoomDetail{
-		Room: &pb.Room{
-			Id:             roomID,
-			Name:           "Battle Room",
-			MaxPlayers:     4,
-			CurrentPlayers: 1,
-		},
-		CurrentPlayers: room.GetPlayerList(),
-	}
+	roomDetail := room.GetRoomDetail()
 
 	return &pb.CreateRoomRpcResponse{Ret: pb.ErrorCode_OK, Room: roomDetail}, nil
 }
 
-func (s *BattleServer) JoinRoomRpc(ctx context.Context, req *pb.JoinRoomRpcRequest) (*pb.JoinRoomRpcResponse, error) {
+// checkRoomCreation 创建房间前检查实例状态和玩家是否已在房间中
+// 检查后会释放锁去访问 Redis，发布房间时（publishRoom）再检查一次
+func (s *BattleServer) checkRoomCreation(playerIDs []uint64) pb.ErrorCode {
 	s.RoomsMutex.RLock()
-	room, exists := s.BattleRooms[req.RoomId]
-	s.RoomsMutex.RUnlock()
+	defer s.RoomsMutex.RUnlock()
+	s.PlayersMutex.RLock()
+	defer s.PlayersMutex.RUnlock()
+	return s.roomCreationConflict(playerIDs)
+}
 
-	if !exists {
-		return &pb.JoinRoomRpcResponse{Ret: pb.ErrorCode_INVALID_ROOM}, nil
+// roomCreationConflict 调用方需持有 RoomsMutex 和 PlayersMutex
+func (s *BattleServer) roomCreationConflict(playerIDs []uint64) pb.ErrorCode {
+	// 维护模式下不再创建房间
+	if s.IsDraining() {
+		return pb.ErrorCode_SERVER_MAINTENANCE
 	}
+	for _, playerID := range playerIDs {
+		if roomID, exists := s.PlayerInRoom[playerID]; exists {
+			slog.Warn("Player already in room", "player_id", playerID, "room_id", roomID)
+			return pb.ErrorCode_PLAYER_ALREADY_IN_ROOM
+		}
+	}
+	return pb.ErrorCode_OK
+}
 
-	room.AddPlayer(req.Player.PlayerId, req.Player.PlayerName)
+// prepareRoom 发布前完成房间的 Redis 写入（邀请码、快照），不持有服务器的锁
+func (s *BattleServer) prepareRoom(room *BattleRoom) {
+	s.assignInviteCode(room)
+	// 房间循环启动前写入快照，启动后房间状态只由房间循环访问
+	if err := room.SaveSnapshot(); err != nil {
+		slog.Error("Failed to save room snapshot", "room_id", room.BattleID, "error", err)
+	}
+}
 
+// publishRoom 再次检查后登记房间和玩家并启动房间循环，成功后记录房间归属
+// 锁内只修改内存状态，Redis 访问都在锁外
+func (s *BattleServer) publishRoom(room *BattleRoom, playerIDs []uint64) pb.ErrorCode {
+	if ret := s.registerRoom(room, playerIDs); ret != pb.ErrorCode_OK {
+		return ret
+	}
+	s.claimRoom(room.BattleID)
+	return pb.ErrorCode_OK
+}
+
+func (s *BattleServer) registerRoom(room *BattleRoom, playerIDs []uint64) pb.ErrorCode {
+	s.RoomsMutex.Lock()
+	defer s.RoomsMutex.Unlock()
 	s.PlayersMutex.Lock()
 	defer s.PlayersMutex.Unlock()
 
-	s.PlayerInRoom[req.Player.PlayerId] = req.RoomId
-
-	slog.Info("Player joined room", "room_id", req.RoomId, "player", req.Player.PlayerId)
+	if ret := s.roomCreationConflict(playerIDs); ret != pb.ErrorCode_OK {
+		return ret
+	}
+	room.Run()
+	s.BattleRooms[room.BattleID] = room
+	for _, playerID := range playerIDs {
+		s.PlayerInRoom[playerID] = room.BattleID
+	}
+	return pb.ErrorCode_OK
+}
 
-	// 广播房间状态给所有玩家（包括新加入的玩家）
-	room.BroadcastRoomStatus()
+// discardRoom 发布失败时删除 prepareRoom 写入的数据，房间循环没有启动
+func (s *BattleServer) discardRoom(room *BattleRoom) {
+	room.releaseInviteCode()
+	room.deleteSnapshot()
+	slog.Info("Discarded unpublished room", "room_id", room.BattleID)
+}
 
-	// 新增：广播玩家初始位置信息
-	room.BroadcastInitialPositions(req.Player.PlayerId)
+func (s *BattleServer) JoinRoomRpc(ctx context.Context, req *pb.JoinRoomRpcRequest) (*pb.JoinRoomRpcResponse, error) {
+	room, exists := s.getRoom(req.RoomId)
+	if !exists {
+		return &pb.JoinRoomRpcResponse{Ret: pb.ErrorCode_INVALID_ROOM}, nil
+	}
 
-	// 返回RoomDetail而不是RoomId
-	roomDetail := &pb.RoomDetail{
-		Room: &pb.Room{
-			Id:             req.RoomId,
-			Name:           "Battle Room",
-			MaxPlayers:     4,
-			CurrentPlayers: int32(len(room.Players)),
-		},
-		CurrentPlayers: room.GetPlayerList(),
+	result, err := room.Join(ctx, req.Player.PlayerId, req.Player.PlayerName, req.Password)
+	if err != nil {
+		return &pb.JoinRoomRpcResponse{Ret: commandErrorCode(err)}, nil
+	}
+	if result.Ret != pb.ErrorCode_OK {
+		return &pb.JoinRoomRpcResponse{Ret: result.Ret}, nil
 	}
 
+	s.PlayersMutex.Lock()
+	s.PlayerInRoom[req.Player.PlayerId] = req.RoomId
+	s.PlayersMutex.Unlock()
+
+	slog.Info("Player joined room", "room_id", req.RoomId, "player", req.Player.PlayerId)
+
+	// 返回RoomDetail而不是RoomId
 	return &pb.JoinRoomRpcResponse{
 		Ret:  pb.ErrorCode_OK,
-		Room: roomDetail,
+		Room: result.Room,
 	}, nil
-
 }
 
 func (s *BattleServer) LeaveRoomRpc(ctx context.Context, req *pb.LeaveRoomRpcRequest) (*pb.LeaveRoomRpcResponse, error) {
@@ -300,39 +416,29 @@ func (s *BattleServer) LeaveRoomRpc(ctx context.Context, req *pb.LeaveRoomRpcReq
 	s.PlayersMutex.Unlock()
 
 	// 从房间中移除玩家
-	s.RoomsMutex.Lock()
-	room, roomExists := s.BattleRooms[roomID]
-	if roomExists {
-		// 从房间中移除玩家
-		room.RemovePlayer(req.PlayerId)
-
-		// 向房间内剩余玩家广播房间状态更新
-		if len(room.Players) > 0 {
-			room.BroadcastRoomStatus()
-			slog.Info("Broadcasted room status after player left", "room_id", roomID, "remaining_players", len(room.Players))
+	var players []*pb.PlayerInitData
+	if room, roomExists := s.getRoom(roomID); roomExists {
+		result, err := room.Leave(ctx, req.PlayerId)
+		if err != nil {
+			slog.Warn("Failed to leave room", "player_id", req.PlayerId, "room_id", roomID, "error", err)
 		}
 
 		// 如果房间没有玩家了，删除房间
-		if len(room.Players) == 0 {
+		if err == nil && result.Ret == pb.ErrorCode_OK && len(result.Remaining) == 0 {
 			slog.Info("Room is empty, removing room", "room_id", roomID)
-			room.Stop() // 停止房间逻辑
-			delete(s.BattleRooms, roomID)
+			s.closeRoom(ctx, room)
 		}
-	}
-	s.RoomsMutex.Unlock()
 
-	slog.Info("Player left room successfully", "player_id", req.PlayerId, "room_id", roomID)
-
-	// 返回房间剩余玩家列表
-	var players []*pb.PlayerInitData
-	if roomExists {
-		for playerID := range room.Players {
+		// 返回房间剩余玩家列表
+		for _, playerID := range result.Remaining {
 			players = append(players, &pb.PlayerInitData{
 				PlayerId: playerID,
 			})
 		}
 	}
 
+	slog.Info("Player left room successfully", "player_id", req.PlayerId, "room_id", roomID)
+
 	return &pb.LeaveRoomRpcResponse{
 		Ret:     pb.ErrorCode_OK,
 		RoomId:  roomID,
@@ -342,103 +448,53 @@ func (s *BattleServer) LeaveRoomRpc(ctx context.Context, req *pb.LeaveRoomRpcReq
 
 func (s *BattleServer) GetReadyRpc(ctx context.Context, req *pb.GetReadyRpcRequest) (*pb.GetReadyRpcResponse, error) {
 	//找到玩家在哪个房间
-	s.PlayersMutex.RLock()
-	roomId, exists := s.PlayerInRoom[req.PlayerId]
-	s.PlayersMutex.RUnlock()
-
+	room, roomId, exists := s.getPlayerRoom(req.PlayerId)
 	if !exists {
 		return &pb.GetReadyRpcResponse{Ret: pb.ErrorCode_INVALID_ROOM}, nil
 	}
 
-	//找到房间
-	s.RoomsMutex.RLock()
-	room, roomExists := s.BattleRooms[roomId]
-	s.RoomsMutex.RUnlock()
-
-	if !roomExists {
-		return &pb.GetReadyRpcResponse{Ret: pb.ErrorCode_INVALID_ROOM}, nil
-	}
-
-	slog.Info("Player ready status change", "player_id", req.PlayerId, "is_ready", req.IsReady)
-
-	//设置状态
-	room.SetPlayerReady(req.PlayerId, req.IsReady)
-	//todo: 通知房间内所有玩家某个玩家准备状态变化 给game 发送 RoomDetailNotify
-	room.BroadcastRoomStatus()
-
-	//房间人数大于等于2人，且全部准备，开始游戏
-	if len(room.Players) >= 2 && room.AllPlayersReady() {
-		slog.Info("All players ready, starting game", "room_id", roomId)
-		// 通知所有玩家游戏开始
-		room.NotifyGameStart()
-		room.StartGame()
+	ret, err := room.Ready(ctx, req.PlayerId, req.IsReady)
+	if err != nil {
+		ret = commandErrorCode(err)
 	}
 
 	return &pb.GetReadyRpcResponse{
-		Ret:    pb.ErrorCode_OK,
+		Ret:    ret,
 		RoomId: roomId,
 	}, nil
 }
 
 // PlayerAction 处理玩家操作
 func (s *BattleServer) PlayerActionRpc(ctx context.Context, req *pb.PlayerActionRpcRequest) (*pb.PlayerActionRpcResponse, error) {
-	s.RoomsMutex.RLock()
-	room, exists := s.BattleRooms[req.RoomId]
-	s.RoomsMutex.RUnlock()
-
+	room, exists := s.getRoom(req.RoomId)
 	if !exists {
 		return &pb.PlayerActionRpcResponse{Ret: pb.ErrorCode_INVALID_ROOM}, nil
 	}
 
-	// 创建一个通道来接收操作结果
-	resultChan := make(chan pb.ErrorCode, 1)
-
-	// 创建一个带结果通道的命令
-	cmd := CommandWithResult{
-		Command: Command{
-			PlayerID: req.PlayerId,
-			Action:   req.Action,
-		},
-		ResultChan: resultChan,
-	}
-
-	// 将操作发送到房间的命令通道
-	room.CmdChanWithResult <- cmd
-
-	// 等待操作结果
-	select {
-	case result := <-resultChan:
-		return &pb.PlayerActionRpcResponse{Ret: result}, nil
-	case <-ctx.Done():
-		return &pb.PlayerActionRpcResponse{Ret: pb.ErrorCode_TIMEOUT}, nil
+	// 将操作发送到房间循环并等待结果
+	ret, err := room.Action(ctx, req.PlayerId, req.Action)
+	if err != nil {
+		ret = commandErrorCode(err)
 	}
+	return &pb.PlayerActionRpcResponse{Ret: ret}, nil
 }
 
-// GetRoomListRpc 获取房间列表
+// GetRoomListRpc 获取房间列表（支持过滤、排序和游标分页）
 func (s *BattleServer) GetRoomListRpc(ctx context.Context, req *pb.GetRoomListRpcRequest) (*pb.GetRoomListRpcResponse, error) {
-	slog.Info("GetRoomListRpc called")
-
-	// 获取所有房间信息
-	s.RoomsMutex.RLock()
-	defer s.RoomsMutex.RUnlock()
+	slog.Info("GetRoomListRpc called", "filter", req.GetFilter())
 
-	var rooms []*pb.Room
-	for roomID, room := range s.BattleRooms {
-		// 创建房间信息
-		roomInfo := &pb.Room{
-			Id:             roomID,
-			Name:           "Battle Room",            // 可以根据需要修改房间名称
-			MaxPlayers:     4,                        // 最大玩家数，可以根据需要调整
-			CurrentPlayers: int32(len(room.Players)), // 当前玩家数
-		}
-		rooms = append(rooms, roomInfo)
+	rooms, nextCursor, err := s.ListRooms(req.GetFilter())
+	if err != nil {
+		slog.Warn("Invalid room list request", "error", err)
+		return &pb.GetRoomListRpcResponse{Ret: pb.ErrorCode_INVALID_PARAM}, nil
 	}
 
-	slog.Info("Returning room list", "count", len(rooms))
+	slog.Info("Returning room list", "count", len(rooms), "has_more", nextCursor != "")
 
 	return &pb.GetRoomListRpcResponse{
-		Ret:   pb.ErrorCode_OK,
-		Rooms: rooms,
+		Ret:        pb.ErrorCode_OK,
+		Rooms:      rooms,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -451,57 +507,108 @@ func (s *BattleServer) MatchCreateRoomRpc(ctx context.Context, req *pb.MatchCrea
 		return &pb.MatchCreateRoomRpcResponse{Ret: pb.ErrorCode_INVALID_PARAM}, nil
 	}
 
-	s.RoomsMutex.Lock()
-	defer s.RoomsMutex.Unlock()
-
-	s.PlayersMutex.Lock()
-	defer s.PlayersMutex.Unlock()
-
-	// 检查所有玩家是否已经在房间中
+	playerIDs := make([]uint64, 0, len(req.Player))
 	for _, player := range req.Player {
-		if roomID, exists := s.PlayerInRoom[player.PlayerId]; exists {
-			slog.Warn("Player already in room", "player_id", player.PlayerId, "room_id", roomID)
-			return &pb.MatchCreateRoomRpcResponse{Ret: pb.ErrorCode_PLAYER_ALREADY_IN_ROOM}, nil
-		}
+		playerIDs = append(playerIDs, player.PlayerId)
+	}
+	if ret := s.checkRoomCreation(playerIDs); ret != pb.ErrorCode_OK {
+		slog.Warn("Rejecting match room creation", "player_count", len(req.Player), "ret", ret)
+		return &pb.MatchCreateRoomRpcResponse{Ret: ret}, nil
 	}
 
 	// 生成房间ID
-	roomID, _ := s.RedisPool.GenerateBattleID()
+	roomID, err := s.RedisPool.GenerateBattleID()
+	if err != nil {
+		slog.Error("Failed to generate battle ID", "player_count", len(req.Player), "error", err)
+		return &pb.MatchCreateRoomRpcResponse{Ret: pb.ErrorCode_SERVER_ERROR}, nil
+	}
+
+	return s.createMatchRoom(ctx, roomID, req.Player, playerIDs), nil
+}
 
+// createMatchRoom 用匹配到的玩家创建房间并启动房间循环，随后向玩家广播房间状态
+func (s *BattleServer) createMatchRoom(ctx context.Context, roomID string, players []*pb.PlayerInitData, playerIDs []uint64) *pb.MatchCreateRoomRpcResponse {
 	// 创建新战斗房间
 	room := NewBattleRoom(roomID, s, GameType_WordCardGame)
+	room.Name = "Match Room"
 
 	// 添加所有匹配的玩家到房间
-	for _, player := range req.Player {
+	for _, player := range players {
 		room.AddPlayer(player.PlayerId, player.PlayerName)
-		s.PlayerInRoom[player.PlayerId] = roomID
 		slog.Info("Added matched player to room", "player_id", player.PlayerId, "player_name", player.PlayerName, "room_id", roomID)
 	}
+	s.prepareRoom(room)
 
 	// 启动房间
-	room.Run()
-	s.BattleRooms[roomID] = room
+	if ret := s.publishRoom(room, playerIDs); ret != pb.ErrorCode_OK {
+		s.discardRoom(room)
+		slog.Warn("Rejecting match room creation", "room_id", roomID, "ret", ret)
+		return &pb.MatchCreateRoomRpcResponse{Ret: ret}
+	}
 
-	slog.Info("Match room created successfully", "room_id", roomID, "player_count", len(req.Player))
+	slog.Info("Match room created successfully", "room_id", roomID, "player_count", len(players))
 
 	// 返回房间详情
-	roomDetail := &pb.RoomDetail{
-		Room: &pb.Room{
-			Id:             roomID,
-			Name:           "Match Room",
-			MaxPlayers:     4,
-			CurrentPlayers: int32(len(req.Player)),
-		},
-		CurrentPlayers: room.GetPlayerList(),
-	}
+	roomDetail := room.GetRoomDetail()
 
-	// 广播房间状态给所有玩家
-	room.BroadcastRoomStatus()
+	// 广播房间状态给所有玩家，房间循环已启动，玩家列表只能在房间循环中遍历
+	if err := room.BroadcastStatus(ctx); err != nil {
+		slog.Warn("Failed to broadcast match room status", "room_id", roomID, "error", err)
+	}
 
 	return &pb.MatchCreateRoomRpcResponse{
 		Ret:  pb.ErrorCode_OK,
 		Room: roomDetail,
-	}, nil
+	}
+}
+
+// ResyncRoomRpc 玩家重连后重新推送所在房间的完整状态
+func (s *BattleServer) ResyncRoomRpc(ctx context.Context, req *pb.ResyncRoomRpcRequest) (*pb.ResyncRoomRpcResponse, error) {
+	room, roomID, exists := s.getPlayerRoom(req.PlayerId)
+	if !exists {
+		return &pb.ResyncRoomRpcResponse{Ret: pb.ErrorCode_NOT_FOUND}, nil
+	}
+
+	slog.Info("Resync room state", "room_id", roomID, "player_id", req.PlayerId)
+
+	ret, err := room.Resync(ctx, req.PlayerId)
+	if err != nil {
+		ret = commandErrorCode(err)
+	}
+
+	return &pb.ResyncRoomRpcResponse{Ret: ret, RoomId: roomID}, nil
+}
+
+// getRoom 根据房间ID查找房间
+func (s *BattleServer) getRoom(roomID string) (*BattleRoom, bool) {
+	s.RoomsMutex.RLock()
+	defer s.RoomsMutex.RUnlock()
+	room, exists := s.BattleRooms[roomID]
+	return room, exists
+}
+
+// getPlayerRoom 查找玩家所在的房间
+func (s *BattleServer) getPlayerRoom(playerID uint64) (*BattleRoom, string, bool) {
+	s.PlayersMutex.RLock()
+	roomID, exists := s.PlayerInRoom[playerID]
+	s.PlayersMutex.RUnlock()
+	if !exists {
+		return nil, "", false
+	}
+
+	room, exists := s.getRoom(roomID)
+	return room, roomID, exists
+}
+
+// closeRoom 从服务器移除房间并停止房间循环
+func (s *BattleServer) closeRoom(ctx context.Context, room *BattleRoom) {
+	s.RoomsMutex.Lock()
+	if s.BattleRooms[room.BattleID] == room {
+		delete(s.BattleRooms, room.BattleID)
+	}
+	s.RoomsMutex.Unlock()
+
+	room.Stop(ctx)
 }
 
 // 获取本机IP
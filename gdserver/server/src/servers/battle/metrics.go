@@ -0,0 +1,43 @@
+package main
+
+import (
+	"common/metrics"
+	pb "proto"
+)
+
+// MetricsPort BattleServer 指标端口，同一台机器运行多个实例时通过 METRICS_ADDR 区分
+const MetricsPort = 9103
+
+var (
+	actionResults = metrics.NewCounterVec("battle_actions_total",
+		"按动作类型和错误码统计的玩家操作结果", "action", "result")
+	notifyFailures = metrics.NewCounterVec("battle_notify_failures_total",
+		"发往GameServer的通知失败数（队列满丢弃、发送失败、投递失败）", "ret")
+)
+
+// registerMetrics 注册依赖服务器状态的指标（进程内只调用一次）
+func (s *BattleServer) registerMetrics() {
+	metrics.NewGaugeFunc("battle_rooms_active", "当前房间数", func() float64 {
+		return float64(s.roomCount())
+	})
+	metrics.NewGaugeFunc("battle_games_active", "当前进行中的游戏数", func() float64 {
+		games := 0
+		for _, room := range s.roomList() {
+			if room.GetRoomInfo().Status == pb.RoomStatus_ROOM_STATUS_PLAYING {
+				games++
+			}
+		}
+		return float64(games)
+	})
+	metrics.NewGaugeFunc("battle_room_loops", "正在运行的房间循环数", func() float64 {
+		return float64(activeRoomLoops.Load())
+	})
+	metrics.NewGaugeFunc("battle_scheduler_timers", "共享时间轮中等待触发的定时器数", func() float64 {
+		return float64(roomScheduler.Pending())
+	})
+}
+
+// observeAction 记录玩家操作结果
+func observeAction(action *pb.GameAction, ret pb.ErrorCode) {
+	actionResults.WithLabelValues(action.GetActionType().String(), ret.String()).Inc()
+}
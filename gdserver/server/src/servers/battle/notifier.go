@@ -0,0 +1,197 @@
+package main
+
+import (
+	"common/tracing"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	pb "proto"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	notifyQueueSize     = 8192            // 待发送通知队列长度
+	notifyBatchSize     = 256             // 每批最多携带的通知数
+	notifyRetryInterval = 1 * time.Second // 通知流断开后的重连间隔
+)
+
+// GameNotifier 战斗服到游戏服的通知流
+// 房间只负责把通知放入队列并立即返回，由发送协程攒批后通过长连接流推送给游戏服。
+// 所有通知经过同一个队列和同一条流按入队顺序发送，因此同一玩家的通知保持有序。
+// 游戏服投递失败（玩家离线、发送队列已满）时通过流异步回报。
+type GameNotifier struct {
+	getClient func() (pb.GameRpcServiceClient, error)
+	queue     chan *pb.PlayerNotification
+	seq       atomic.Uint64
+	dropped   atomic.Uint64 // 队列已满被丢弃的通知数
+	failed    atomic.Uint64 // 游戏服回报投递失败的通知数
+	stopCh    chan struct{}
+}
+
+func NewGameNotifier(getClient func() (pb.GameRpcServiceClient, error)) *GameNotifier {
+	return &GameNotifier{
+		getClient: getClient,
+		queue:     make(chan *pb.PlayerNotification, notifyQueueSize),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// Start 启动发送协程
+func (n *GameNotifier) Start() {
+	go n.run()
+}
+
+// Stop 停止发送协程，队列中未发送的通知被丢弃
+func (n *GameNotifier) Stop() {
+	close(n.stopCh)
+}
+
+// Flush 等待队列中的通知发送完毕，最多等待 timeout
+func (n *GameNotifier) Flush(timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for len(n.queue) > 0 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if pending := len(n.queue); pending > 0 {
+		slog.Warn("Notifications not flushed before shutdown", "pending", pending)
+	}
+}
+
+// Notify 将发给玩家的通知放入队列后立即返回，ctx 中的追踪上下文随通知传给游戏服
+func (n *GameNotifier) Notify(ctx context.Context, playerID uint64, msgID pb.MessageId, msg proto.Message) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		slog.Error("Failed to marshal notification", "player_id", playerID, "msg_id", msgID, "error", err)
+		return
+	}
+
+	item := &pb.PlayerNotification{
+		BeNotifiedUid: playerID,
+		Seq:           n.seq.Add(1),
+		MsgId:         msgID,
+		Data:          data,
+		Traceparent:   tracing.Traceparent(ctx),
+	}
+
+	select {
+	case n.queue <- item:
+	default:
+		// 队列已满说明游戏服长时间不可用，丢弃而不是阻塞房间
+		n.dropped.Add(1)
+		n.reportFailure(&pb.NotificationFailure{
+			Seq:           item.Seq,
+			BeNotifiedUid: item.BeNotifiedUid,
+			Ret:           pb.ErrorCode_SERVER_BUSY,
+		})
+	}
+}
+
+func (n *GameNotifier) run() {
+	for {
+		select {
+		case <-n.stopCh:
+			return
+		default:
+		}
+
+		stream, cancel, err := n.openStream()
+		if err != nil {
+			slog.Error("Failed to open notify stream", "error", err)
+			select {
+			case <-n.stopCh:
+				return
+			case <-time.After(notifyRetryInterval):
+			}
+			continue
+		}
+
+		slog.Info("Notify stream established")
+		n.serve(stream)
+		cancel()
+	}
+}
+
+func (n *GameNotifier) openStream() (pb.GameRpcService_NotifyStreamClient, context.CancelFunc, error) {
+	client, err := n.getClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.NotifyStream(ctx)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	return stream, cancel, nil
+}
+
+// serve 在一条流上发送通知，流断开或停止时返回
+func (n *GameNotifier) serve(stream pb.GameRpcService_NotifyStreamClient) {
+	// 接收协程：处理游戏服异步回报的投递失败
+	recvDone := make(chan struct{})
+	go func() {
+		defer close(recvDone)
+		for {
+			ack, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					slog.Warn("Notify stream receive failed", "error", err)
+				}
+				return
+			}
+			for _, failure := range ack.Failures {
+				n.failed.Add(1)
+				n.reportFailure(failure)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-n.stopCh:
+			stream.CloseSend()
+			return
+		case <-recvDone:
+			return
+		case item := <-n.queue:
+			batch := n.drainBatch(item)
+			if err := stream.Send(&pb.NotificationBatch{Notifications: batch}); err != nil {
+				slog.Error("Failed to send notification batch", "count", len(batch), "error", err)
+				for _, lost := range batch {
+					n.reportFailure(&pb.NotificationFailure{
+						Seq:           lost.Seq,
+						BeNotifiedUid: lost.BeNotifiedUid,
+						Ret:           pb.ErrorCode_SERVER_ERROR,
+					})
+				}
+				return
+			}
+		}
+	}
+}
+
+// drainBatch 以 first 开头，取出队列中已经就绪的通知组成一批（不等待，空闲时单条立即发送）
+func (n *GameNotifier) drainBatch(first *pb.PlayerNotification) []*pb.PlayerNotification {
+	batch := make([]*pb.PlayerNotification, 1, notifyBatchSize)
+	batch[0] = first
+	for len(batch) < notifyBatchSize {
+		select {
+		case item := <-n.queue:
+			batch = append(batch, item)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (n *GameNotifier) reportFailure(failure *pb.NotificationFailure) {
+	notifyFailures.WithLabelValues(failure.Ret.String()).Inc()
+	slog.Warn("Notification delivery failed", "player_id", failure.BeNotifiedUid, "seq", failure.Seq,
+		"ret", failure.Ret, "dropped_total", n.dropped.Load(), "failed_total", n.failed.Load())
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	pb "proto"
+	"time"
+
+	"common/redisutil"
+)
+
+const (
+	roomSnapshotKeyPrefix = "battle:room:"        // 房间快照 battle:room:{room_id}
+	ownedRoomsKeyPrefix   = "battle:owned_rooms:" // 实例拥有的房间集合 battle:owned_rooms:{server_id}
+	roomSnapshotTTL       = 24 * time.Hour        // 快照过期时间，防止残留数据永久占用
+)
+
+// RoomSnapshotInterval 房间快照写入周期，可通过 BATTLE_SNAPSHOT_INTERVAL 配置（如 "5s"）
+var RoomSnapshotInterval = loadDurationFromEnv("BATTLE_SNAPSHOT_INTERVAL", 5*time.Second)
+
+// RoomSnapshot 房间持久化数据（房间元信息 + 游戏状态）
+type RoomSnapshot struct {
+	RoomID       string          `json:"room_id"`
+	Name         string          `json:"name"`
+	Password     string          `json:"password,omitempty"`
+	InviteCode   string          `json:"invite_code,omitempty"`
+	GameType     GameType        `json:"game_type"`
+	CreateTime   int64           `json:"create_time"`
+	GameStarted  bool            `json:"game_started"`
+	State        pb.RoomStatus   `json:"state"`
+	Players      []PlayerInfo    `json:"players"`
+	ReadyPlayers []uint64        `json:"ready_players,omitempty"`
+	GameData     json.RawMessage `json:"game_data,omitempty"` // 由具体 Game 实现序列化
+	SavedAt      int64           `json:"saved_at"`
+}
+
+// loadBattleServerID 获取稳定的服务器标识，重启后保持不变，用于找回本实例拥有的房间
+func loadBattleServerID(port int) string {
+	if id := os.Getenv("BATTLE_SERVER_ID"); id != "" {
+		return id
+	}
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s:%d", hostname, port)
+}
+
+func loadDurationFromEnv(name string, def time.Duration) time.Duration {
+	if v := os.Getenv(name); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+		slog.Warn("Invalid duration in env, using default", "env", name, "value", v, "default", def)
+	}
+	return def
+}
+
+func roomSnapshotKey(roomID string) string {
+	return roomSnapshotKeyPrefix + roomID
+}
+
+func (s *BattleServer) ownedRoomsKey() string {
+	return ownedRoomsKeyPrefix + s.ServerID
+}
+
+// markDirty 标记房间状态已变化，下一个快照周期写入Redis
+func (room *BattleRoom) markDirty() {
+	room.dirty.Store(true)
+}
+
+// buildSnapshot 构造房间快照
+func (room *BattleRoom) buildSnapshot() (*RoomSnapshot, error) {
+	room.PlayersMutex.RLock()
+	snapshot := &RoomSnapshot{
+		RoomID:      room.BattleID,
+		Name:        room.Name,
+		Password:    room.Password,
+		InviteCode:  room.InviteCode,
+		GameType:    room.GameType,
+		CreateTime:  room.CreateTime.UnixMilli(),
+		GameStarted: room.IsGameStarted(),
+		State:       room.State,
+		SavedAt:     time.Now().UnixMilli(),
+	}
+	for _, info := range room.Players {
+		snapshot.Players = append(snapshot.Players, *info)
+	}
+	for playerID := range room.ReadyPlayers {
+		snapshot.ReadyPlayers = append(snapshot.ReadyPlayers, playerID)
+	}
+	room.PlayersMutex.RUnlock()
+
+	if room.IsGameStarted() && room.Game != nil {
+		data, err := room.Game.Snapshot()
+		if err != nil {
+			return nil, fmt.Errorf("snapshot game failed: %w", err)
+		}
+		snapshot.GameData = data
+	}
+	return snapshot, nil
+}
+
+// SaveSnapshot 将房间快照写入Redis，登记到本实例拥有的房间集合，并为房间归属记录续期
+func (room *BattleRoom) SaveSnapshot() error {
+	if room.Server == nil || room.Server.RedisPool == nil {
+		return nil
+	}
+
+	snapshot, err := room.buildSnapshot()
+	if err != nil {
+		return err
+	}
+
+	redisPool := room.Server.RedisPool
+	if err := redisPool.SetJSON(roomSnapshotKey(room.BattleID), snapshot, roomSnapshotTTL); err != nil {
+		return err
+	}
+	if err := redisPool.SAdd(room.Server.ownedRoomsKey(), room.BattleID); err != nil {
+		return err
+	}
+	room.Server.refreshRoomOwner(room.BattleID)
+	room.dirty.Store(false)
+	return nil
+}
+
+// saveSnapshotIfDirty 周期性调用，只有状态变化时才写入
+func (room *BattleRoom) saveSnapshotIfDirty() {
+	if !room.dirty.Load() {
+		return
+	}
+	// 已关闭的房间快照已删除，不再写入
+	room.PlayersMutex.RLock()
+	closed := room.Closed
+	room.PlayersMutex.RUnlock()
+	if closed {
+		return
+	}
+	if err := room.SaveSnapshot(); err != nil {
+		slog.Error("Failed to save room snapshot", "room_id", room.BattleID, "error", err)
+	}
+}
+
+// deleteSnapshot 房间关闭后删除快照，重启时不再恢复
+func (room *BattleRoom) deleteSnapshot() {
+	if room.Server == nil || room.Server.RedisPool == nil {
+		return
+	}
+	redisPool := room.Server.RedisPool
+	if err := redisPool.Delete(roomSnapshotKey(room.BattleID)); err != nil {
+		slog.Warn("Failed to delete room snapshot", "room_id", room.BattleID, "error", err)
+	}
+	if err := redisPool.SRem(room.Server.ownedRoomsKey(), room.BattleID); err != nil {
+		slog.Warn("Failed to remove room from owned set", "room_id", room.BattleID, "error", err)
+	}
+}
+
+// restoreRoom 根据快照重建房间（不启动房间循环）
+func (s *BattleServer) restoreRoom(snapshot *RoomSnapshot) (*BattleRoom, error) {
+	if len(snapshot.Players) == 0 {
+		return nil, errors.New("snapshot has no players")
+	}
+
+	room := NewBattleRoom(snapshot.RoomID, s, snapshot.GameType)
+	if room.Game == nil {
+		return nil, fmt.Errorf("unsupported game type %d", snapshot.GameType)
+	}
+	room.Name = snapshot.Name
+	room.Password = snapshot.Password
+	room.InviteCode = snapshot.InviteCode
+	room.CreateTime = time.UnixMilli(snapshot.CreateTime)
+
+	for i := range snapshot.Players {
+		info := snapshot.Players[i]
+		room.Players[info.PlayerID] = &info
+	}
+	for _, playerID := range snapshot.ReadyPlayers {
+		room.ReadyPlayers[playerID] = true
+	}
+
+	switch {
+	case snapshot.GameStarted && len(snapshot.GameData) > 0:
+		if err := room.Game.Restore(snapshot.GameData); err != nil {
+			return nil, fmt.Errorf("restore game failed: %w", err)
+		}
+		room.State = pb.RoomStatus_ROOM_STATUS_PLAYING
+	case snapshot.State == pb.RoomStatus_ROOM_STATUS_COUNTDOWN:
+		// 倒计时重新开始
+		room.State = pb.RoomStatus_ROOM_STATUS_COUNTDOWN
+		room.StateDeadline = time.Now().Add(GameCountdownDuration)
+	default:
+		// 结算中的房间直接回到等待中
+		room.State = pb.RoomStatus_ROOM_STATUS_WAITING
+	}
+
+	return room, nil
+}
+
+// RestoreRooms 启动时从Redis恢复本实例拥有的房间和游戏，并向在线玩家推送完整状态
+func (s *BattleServer) RestoreRooms() {
+	roomIDs, err := s.RedisPool.SMembers(s.ownedRoomsKey())
+	if err != nil {
+		slog.Error("Failed to load owned rooms", "server_id", s.ServerID, "error", err)
+		return
+	}
+
+	var restored []*BattleRoom
+	for _, roomID := range roomIDs {
+		var snapshot RoomSnapshot
+		if err := s.RedisPool.GetJSON(roomSnapshotKey(roomID), &snapshot); err != nil {
+			if !errors.Is(err, redisutil.ErrKeyNotFound) {
+				slog.Error("Failed to load room snapshot", "room_id", roomID, "error", err)
+			}
+			s.RedisPool.SRem(s.ownedRoomsKey(), roomID)
+			continue
+		}
+
+		room, err := s.restoreRoom(&snapshot)
+		if err != nil {
+			slog.Error("Failed to restore room", "room_id", roomID, "error", err)
+			s.RedisPool.Delete(roomSnapshotKey(roomID))
+			s.RedisPool.SRem(s.ownedRoomsKey(), roomID)
+			continue
+		}
+
+		s.RoomsMutex.Lock()
+		s.BattleRooms[roomID] = room
+		s.RoomsMutex.Unlock()
+
+		s.PlayersMutex.Lock()
+		for playerID := range room.Players {
+			s.PlayerInRoom[playerID] = roomID
+		}
+		s.PlayersMutex.Unlock()
+
+		// 实例地址可能变化，重新登记房间归属
+		s.claimRoom(roomID)
+
+		slog.Info("Room restored from snapshot", "room_id", roomID, "players", len(room.Players),
+			"state", room.State, "saved_at", snapshot.SavedAt)
+
+		room.Run()
+		restored = append(restored, room)
+	}
+
+	slog.Info("Room restore finished", "server_id", s.ServerID, "restored", len(restored), "owned", len(roomIDs))
+
+	// 恢复后推送完整状态，已经断线的玩家重连后通过 ResyncRoomRpc 获取
+	for _, room := range restored {
+		go func(room *BattleRoom) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if _, err := room.Resync(ctx, 0); err != nil {
+				slog.Warn("Failed to push restored room state", "room_id", room.BattleID, "error", err)
+			}
+		}(room)
+	}
+}
+
+// SendFullState 推送完整的房间状态（和进行中的游戏状态），playerID 为 0 表示房间内所有玩家
+func (room *BattleRoom) SendFullState(playerID uint64) {
+	detail := room.GetRoomDetail()
+
+	room.PlayersMutex.RLock()
+	var targets []uint64
+	for id := range room.Players {
+		if playerID == 0 || id == playerID {
+			targets = append(targets, id)
+		}
+	}
+	room.PlayersMutex.RUnlock()
+
+	var state *pb.GameState
+	if room.IsGameStarted() && room.Game != nil {
+		state = room.Game.GetState()
+	}
+
+	for _, id := range targets {
+		room.NotifyRoomStatus(id, detail)
+		if state != nil {
+			room.NotifyGameState(id, &pb.GameStateNotify{
+				RoomId:    room.BattleID,
+				GameState: state,
+			})
+		}
+	}
+}
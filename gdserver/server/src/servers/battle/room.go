@@ -6,20 +6,45 @@ import (
 	"math/rand"
 	pb "proto"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	MaxRoomPlayers = 4 // 房间最大玩家数
 )
 
 type BattleRoom struct {
-	BattleID          string
-	Server            *BattleServer
-	Game              Game
-	GameType          GameType
-	GameStarted       bool // 游戏是否已经开始（区分等待状态和游戏进行状态）
-	ReadyPlayers      map[uint64]bool
-	CmdChan           chan Command
-	CmdChanWithResult chan CommandWithResult
-	Players           map[uint64]*PlayerInfo
-	PlayersMutex      sync.RWMutex
+	BattleID      string
+	Name          string // 房间名称
+	Password      string // 房间密码，为空表示公开房间
+	InviteCode    string // 房间邀请码
+	CreateTime    time.Time
+	Server        *BattleServer
+	Game          Game
+	GameType      GameType
+	State         pb.RoomStatus // 房间状态：等待中 -> 倒计时 -> 游戏中 -> 结算 -> 等待中
+	StateDeadline time.Time     // 倒计时/结算的结束时间
+	Closed        bool          // 房间是否已关闭（停止或销毁后不再接受加入）
+	ReadyPlayers  map[uint64]bool
+	cmdChan       chan RoomCommand   // 房间命令，只在房间循环中执行
+	ctx           context.Context    // 房间生命周期，取消后房间循环退出
+	cancel        context.CancelFunc // 停止房间循环
+	done          chan struct{}      // 房间循环退出后关闭
+	lastActive    time.Time          // 最近一次房间命令的时间，用于空闲回收（仅房间循环访问）
+	emptySince    time.Time          // 房间变空的时间，用于空房间回收（仅房间循环访问）
+	draining      bool               // 服务器维护中，游戏结束后关闭房间（仅房间循环访问）
+	wake          chan struct{}      // 共享时间轮到期后唤醒房间循环
+	wakeTimer     *Timer             // 当前登记的唤醒定时器（仅房间循环访问）
+	wakeAt        time.Time          // 当前登记的唤醒时间（仅房间循环访问）
+	nextSnapshot  time.Time          // 下一次写入快照的时间，状态无变化时为零值（仅房间循环访问）
+	nextGC        time.Time          // 下一次空闲检查的时间（仅房间循环访问）
+	traceCtx      context.Context    // 正在执行的命令的追踪上下文（仅房间循环访问）
+	Players       map[uint64]*PlayerInfo
+	PlayersMutex  sync.RWMutex
+	dirty         atomic.Bool // 状态是否有未写入快照的变化
 }
 
 type PlayerInfo struct {
@@ -31,6 +56,8 @@ type PlayerInfo struct {
 	PositionY int32
 	// 标记是否已经发送过第一次位置更新
 	HasSentInitialPosition bool
+	// 在大厅中开始未准备的时间，用于移出长时间未准备的玩家（仅房间循环访问）
+	idleSince time.Time
 }
 
 type Command struct {
@@ -38,23 +65,24 @@ type Command struct {
 	Action   *pb.GameAction
 }
 
-// CommandWithResult 带有结果通道的命令结构体
-type CommandWithResult struct {
-	Command
-	ResultChan chan pb.ErrorCode
-}
-
 func NewBattleRoom(battleID string, server *BattleServer, gameType GameType) *BattleRoom {
 	rand.Seed(time.Now().UnixNano())
 
+	ctx, cancel := context.WithCancel(context.Background())
 	room := &BattleRoom{
-		BattleID:          battleID,
-		Server:            server,
-		GameType:          gameType,
-		ReadyPlayers:      make(map[uint64]bool),
-		CmdChan:           make(chan Command, 100),
-		CmdChanWithResult: make(chan CommandWithResult, 100),
-		Players:           make(map[uint64]*PlayerInfo),
+		BattleID:     battleID,
+		Name:         "Battle Room",
+		CreateTime:   time.Now(),
+		Server:       server,
+		GameType:     gameType,
+		ReadyPlayers: make(map[uint64]bool),
+		cmdChan:      make(chan RoomCommand, 100),
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		wake:         make(chan struct{}, 1),
+		lastActive:   time.Now(),
+		Players:      make(map[uint64]*PlayerInfo),
 	}
 
 	// 创建游戏实例
@@ -78,8 +106,11 @@ func (room *BattleRoom) AddPlayer(playerID uint64, name string) {
 		PositionY: 0,
 		// 初始状态为未发送位置
 		HasSentInitialPosition: false,
+		idleSince:              time.Now(),
 	}
 
+	room.markDirty()
+
 	slog.Info("Player added to battle room", "room_id", room.BattleID, "player_id", playerID, "player_name", name)
 }
 
@@ -91,6 +122,7 @@ func (room *BattleRoom) RemovePlayer(playerID uint64) {
 	if _, exists := room.Players[playerID]; exists {
 		delete(room.Players, playerID)
 		delete(room.ReadyPlayers, playerID) // 同时移除准备状态
+		room.markDirty()
 		slog.Info("Player removed from battle room", "room_id", room.BattleID, "player_id", playerID)
 
 		// 如果游戏正在进行中，需要更新游戏状态
@@ -103,32 +135,27 @@ func (room *BattleRoom) RemovePlayer(playerID uint64) {
 			// 广播更新后的游戏状态
 			room.BroadcastGameState()
 		}
-
-		// 检查房间是否为空，如果为空则销毁房间
-		if len(room.Players) == 0 {
-			slog.Info("Room is now empty, scheduling room destruction", "room_id", room.BattleID)
-			// 延迟销毁房间，给客户端一些时间处理
-			// 传递房间ID而不是直接调用DestroyRoom，避免锁竞争
-			roomID := room.BattleID
-			go func() {
-				time.Sleep(1 * time.Second)
-				room.destroyEmptyRoom(roomID)
-			}()
-		}
 	}
 }
 
-// Stop 停止战斗房间
-func (room *BattleRoom) Stop() {
-	room.PlayersMutex.Lock()
-	defer room.PlayersMutex.Unlock()
+// shutdown 结束游戏并释放房间资源，房间循环随后退出（在房间循环中调用）
+func (room *BattleRoom) shutdown() {
+	if room.Closed {
+		return
+	}
+	defer room.cancel()
 
 	if room.Game != nil {
 		room.Game.EndGame()
 	}
 
-	// 关闭命令通道（如果需要的话）
-	// close(room.CmdChan) // 注意：需要确保没有其他goroutine在写入
+	room.PlayersMutex.Lock()
+	room.Closed = true
+	room.PlayersMutex.Unlock()
+
+	room.releaseInviteCode()
+	room.deleteSnapshot()
+	room.Server.releaseRoom(room.BattleID)
 
 	slog.Info("Battle room stopped", "room_id", room.BattleID)
 }
@@ -146,6 +173,7 @@ func (room *BattleRoom) SetPlayerReady(playerID uint64, isReady bool) {
 	} else {
 		delete(room.ReadyPlayers, playerID)
 	}
+	room.markDirty()
 }
 
 func (room *BattleRoom) AllPlayersReady() bool {
@@ -155,9 +183,112 @@ func (room *BattleRoom) AllPlayersReady() bool {
 	return len(room.Players) > 0 && len(room.ReadyPlayers) == len(room.Players)
 }
 
+// handleJoin 玩家加入房间（在房间循环中调用）
+func (room *BattleRoom) handleJoin(playerID uint64, name, password string) pb.ErrorCode {
+	if _, exists := room.Players[playerID]; exists {
+		return pb.ErrorCode_PLAYER_ALREADY_IN_ROOM
+	}
+	// 已开始/已结束的房间和满员房间不允许加入
+	if room.Status() != pb.RoomStatus_ROOM_STATUS_WAITING {
+		slog.Warn("Room not joinable", "room_id", room.BattleID, "status", room.Status())
+		return pb.ErrorCode_INVALID_STATE
+	}
+	if len(room.Players) >= MaxRoomPlayers {
+		slog.Warn("Room is full", "room_id", room.BattleID)
+		return pb.ErrorCode_ROOM_FULL
+	}
+	if !room.CheckPassword(password) {
+		slog.Warn("Wrong room password", "room_id", room.BattleID, "player", playerID)
+		return pb.ErrorCode_WRONG_PASSWORD
+	}
+
+	room.AddPlayer(playerID, name)
+
+	// 广播房间状态给所有玩家（包括新加入的玩家）
+	room.BroadcastRoomStatus()
+
+	// 新增：广播玩家初始位置信息
+	room.BroadcastInitialPositions(playerID)
+
+	return pb.ErrorCode_OK
+}
+
+// handleLeave 玩家离开房间（在房间循环中调用），返回剩余玩家
+func (room *BattleRoom) handleLeave(playerID uint64) LeaveResult {
+	if _, exists := room.Players[playerID]; !exists {
+		return LeaveResult{Ret: pb.ErrorCode_INVALID_ROOM}
+	}
+
+	room.RemovePlayer(playerID)
+
+	// 倒计时中有人离开，取消倒计时
+	room.updateCountdown()
+
+	remaining := make([]uint64, 0, len(room.Players))
+	for id := range room.Players {
+		remaining = append(remaining, id)
+	}
+
+	// 向房间内剩余玩家广播房间状态更新
+	if len(remaining) > 0 {
+		room.BroadcastRoomStatus()
+		slog.Info("Broadcasted room status after player left", "room_id", room.BattleID, "remaining_players", len(remaining))
+	}
+
+	return LeaveResult{Ret: pb.ErrorCode_OK, Remaining: remaining}
+}
+
+// handleReady 设置准备状态，房间人数大于等于2人且全部准备时开始倒计时，倒计时中取消准备则回到等待（在房间循环中调用）
+func (room *BattleRoom) handleReady(playerID uint64, isReady bool) pb.ErrorCode {
+	info, exists := room.Players[playerID]
+	if !exists {
+		return pb.ErrorCode_INVALID_ROOM
+	}
+	if room.State != pb.RoomStatus_ROOM_STATUS_WAITING && room.State != pb.RoomStatus_ROOM_STATUS_COUNTDOWN {
+		return pb.ErrorCode_INVALID_STATE
+	}
+
+	slog.Info("Player ready status change", "player_id", playerID, "is_ready", isReady)
+
+	room.SetPlayerReady(playerID, isReady)
+	if !isReady {
+		info.idleSince = time.Now()
+	}
+	room.updateCountdown()
+	room.BroadcastRoomStatus()
+	return pb.ErrorCode_OK
+}
+
+// handleInvite 校验邀请者并准备邀请码（在房间循环中调用）
+func (room *BattleRoom) handleInvite(inviterID uint64) InviteResult {
+	if _, exists := room.Players[inviterID]; !exists {
+		slog.Warn("Inviter not in room", "room_id", room.BattleID, "inviter", inviterID)
+		return InviteResult{Ret: pb.ErrorCode_NOT_ALLOWED}
+	}
+	if room.Status() != pb.RoomStatus_ROOM_STATUS_WAITING {
+		return InviteResult{Ret: pb.ErrorCode_INVALID_STATE}
+	}
+	if len(room.Players) >= MaxRoomPlayers {
+		return InviteResult{Ret: pb.ErrorCode_ROOM_FULL}
+	}
+
+	// 邀请码不存在（之前分配失败）时补发，存在时续期
+	if room.InviteCode == "" {
+		room.Server.assignInviteCode(room)
+	} else if err := room.Server.RedisPool.RefreshRoomInviteCode(room.InviteCode, RoomInviteCodeTTL); err != nil {
+		slog.Warn("Failed to refresh room invite code", "room_id", room.BattleID, "error", err)
+	}
+	if room.InviteCode == "" {
+		return InviteResult{Ret: pb.ErrorCode_SERVER_ERROR}
+	}
+
+	return InviteResult{Ret: pb.ErrorCode_OK, Room: room.GetRoomInfo(), InviteCode: room.InviteCode}
+}
+
 func (room *BattleRoom) StartGame() {
 	slog.Info("Starting new game", "room_id", room.BattleID, "players_count", len(room.Players))
 
+	room.PlayersMutex.Lock()
 	// 如果没有游戏实例（游戏结束后），重新创建
 	if room.Game == nil {
 		room.Game = GameFactory(room.GameType)
@@ -178,10 +309,13 @@ func (room *BattleRoom) StartGame() {
 	// 初始化并开始游戏
 	room.Game.Init(players)
 	room.Game.Start()
-	room.GameStarted = true // 标记游戏已开始
+	room.State = pb.RoomStatus_ROOM_STATUS_PLAYING // 标记游戏已开始
+	room.StateDeadline = time.Time{}
 
 	// 清空准备状态，避免下局继承（若房间复用）
 	room.ReadyPlayers = make(map[uint64]bool)
+	room.PlayersMutex.Unlock()
+	room.markDirty()
 
 	// 广播游戏状态
 	room.BroadcastGameState()
@@ -205,83 +339,63 @@ func (room *BattleRoom) BroadcastGameState() {
 	}
 }
 
+// Run 启动房间循环，房间状态只在该循环中修改
+// 房间没有独立的 Ticker，需要定时处理时通过共享时间轮唤醒（见 room_schedule.go）
+// 房间关闭（Stop、空闲回收）或 ctx 被取消时循环退出，唤醒定时器随之取消
 func (room *BattleRoom) Run() {
-	go func() {
-		gameTicker := time.NewTicker(100 * time.Millisecond)
-		defer gameTicker.Stop()
-
-		for {
-			select {
-			case cmd := <-room.CmdChan:
-				room.HandlePlayerCommand(cmd)
-			case cmdWithResult := <-room.CmdChanWithResult:
-				result := room.HandlePlayerCommandWithResult(cmdWithResult.Command)
-				// 将结果发送回结果通道
-				select {
-				case cmdWithResult.ResultChan <- result:
-				default:
-					// 如果通道已满或关闭，忽略结果
-				}
-			case <-gameTicker.C:
-				// 将游戏逻辑更新的职责交给game
-				if room.Game != nil {
-					if !room.Game.Update() {
-						room.EndGame()
-						slog.Info("Game ended", "room_id", room.BattleID)
-						// 不要return，继续处理后续的房间事件
-						// 游戏结束后房间依然需要处理玩家准备等事件
-					}
-				}
-			}
-		}
-	}()
+	go room.loop()
 }
 
-func (room *BattleRoom) HandlePlayerCommand(cmd Command) {
-	// 确保Command不为nil
-	if cmd.Action == nil {
-		slog.Error("[Battle] Action is nil", "player_id", cmd.PlayerID)
-		return
-	}
+func (room *BattleRoom) loop() {
+	defer close(room.done)
+	activeRoomLoops.Add(1)
+	defer activeRoomLoops.Add(-1)
+	defer room.cancelWakeup()
 
-	// ===========================================
-	// Room层面处理：跨游戏状态的功能
-	// ===========================================
+	room.nextGC = time.Now().Add(RoomGCInterval)
+	room.scheduleWakeup()
 
-	switch cmd.Action.ActionType {
-	case pb.ActionType_CHAR_MOVE:
-		// 位置移动在Room层面处理（大厅、游戏中都需要）
-		room.handleCharMoveInRoom(cmd)
-		return
-
-		// case pb.ActionType_AUTO_CHAT:
-		// 	// 聊天在Room层面处理（游戏前后都能聊天）
-		// 	room.handleChatInRoom(cmd)
-		// 	return
+	for {
+		select {
+		case <-room.ctx.Done():
+			// 服务器退出时只停止循环，写入最新快照以便重启后恢复
+			if !room.Closed {
+				room.saveSnapshotIfDirty()
+			}
+			return
+		case cmd := <-room.cmdChan:
+			cmd.Execute(room)
+			if room.Closed {
+				return
+			}
+			room.touch()
+			// 玩家操作可能结束游戏
+			if room.updateGame(); room.Closed {
+				return
+			}
+		case <-room.wake:
+			if room.onWakeup(time.Now()); room.Closed {
+				return
+			}
+		}
+		room.scheduleWakeup()
 	}
+}
 
-	// ===========================================
-	// Game层面处理：游戏逻辑相关的功能
-	// ===========================================
-
-	// 检查游戏是否已开始
-	if room.Game == nil {
-		slog.Warn("[Battle] Game not started yet, ignoring game action",
-			"action_type", cmd.Action.ActionType, "player_id", cmd.PlayerID)
+// updateGame 调用游戏更新，游戏结束时进入结算（在房间循环中调用）
+func (room *BattleRoom) updateGame() {
+	// 将游戏逻辑更新的职责交给game
+	if !room.IsGameStarted() || room.Game == nil || room.Game.Update() {
 		return
 	}
 
-	// 确保Game实例有效后再调用HandleAction
-	if room.Game != nil {
-		// 游戏层面的操作（卡牌、回合等）
-		result := room.Game.HandleAction(cmd.PlayerID, cmd.Action)
-		if result == pb.ErrorCode_OK {
-			room.BroadcastGameState()
-		}
-	} else {
-		slog.Error("[Battle] Game instance is nil when handling action",
-			"action_type", cmd.Action.ActionType, "player_id", cmd.PlayerID)
+	room.EndGame()
+	slog.Info("Game ended", "room_id", room.BattleID)
+	if room.draining {
+		// 服务器维护中，游戏结束后不再开始下一局
+		room.closeWithNotice(pb.RoomCloseReason_ROOM_CLOSE_MAINTENANCE)
 	}
+	// 游戏结束后房间依然需要处理玩家准备等事件
 }
 
 // HandlePlayerCommandWithResult 处理带结果的玩家命令
@@ -313,7 +427,7 @@ func (room *BattleRoom) HandlePlayerCommandWithResult(cmd Command) pb.ErrorCode
 	// ===========================================
 
 	// 检查游戏是否已开始
-	if room.Game == nil {
+	if !room.IsGameStarted() || room.Game == nil {
 		slog.Warn("[Battle] Game not started yet, ignoring game action",
 			"action_type", cmd.Action.ActionType, "player_id", cmd.PlayerID)
 		return pb.ErrorCode_INVALID_STATE
@@ -324,6 +438,7 @@ func (room *BattleRoom) HandlePlayerCommandWithResult(cmd Command) pb.ErrorCode
 		// 游戏层面的操作（卡牌、回合等）
 		result := room.Game.HandleAction(cmd.PlayerID, cmd.Action)
 		if result == pb.ErrorCode_OK {
+			room.markDirty()
 			room.BroadcastGameState()
 		}
 		return result
@@ -374,12 +489,13 @@ func (room *BattleRoom) handleCharMoveInRoom(cmd Command) {
 	}
 
 	room.PlayersMutex.Unlock()
+	room.markDirty()
 
 	slog.Info("[Battle] Player moved in room", "player_id", cmd.PlayerID,
 		"from_x", moveAction.FromX, "from_y", moveAction.FromY, "to_x", moveAction.ToX, "to_y", moveAction.ToY)
 
 	// 如果游戏已开始，同时更新游戏层面的位置状态
-	if room.Game != nil {
+	if room.IsGameStarted() && room.Game != nil {
 		// 调用游戏层面的位置更新（更新游戏内玩家对象的位置）
 		room.Game.HandleAction(cmd.PlayerID, cmd.Action)
 	}
@@ -396,41 +512,85 @@ func (room *BattleRoom) handleCharMoveInRoom(cmd Command) {
 func (room *BattleRoom) EndGame() {
 	slog.Info("Game ended", "room", room.BattleID)
 
-	// 标记游戏已结束，但保留房间
-	room.GameStarted = false
+	// 在重置游戏前，同步胜利次数到房间玩家信息
+	room.syncWinCountFromGame()
+
+	room.PlayersMutex.Lock()
+	// 标记游戏已结束进入结算，但保留房间
+	room.State = pb.RoomStatus_ROOM_STATUS_SETTLEMENT
+	room.StateDeadline = time.Now().Add(SettlementDuration)
 
 	// 重置游戏实例，为下一局做准备
-	// 注意：不再调用 room.Game.EndGame()，因为在 Run() 方法中已经调用过了
 	if room.Game != nil {
-		// 在重置游戏前，同步胜利次数到房间玩家信息
-		room.syncWinCountFromGame()
-
 		room.Game.EndGame()
 		room.Game = nil
 	}
 
 	// 清空准备状态，玩家需要重新准备
 	room.ReadyPlayers = make(map[uint64]bool)
+	room.PlayersMutex.Unlock()
+	room.markDirty()
 
 	slog.Info("Game ended, room remains active for next game", "room_id", room.BattleID, "players_count", len(room.Players))
 
-	// 广播房间状态更新，显示玩家可以重新准备
+	// 广播房间状态更新，结算结束后玩家可以重新准备
 	room.BroadcastRoomStatus()
 }
 
+// BroadcastRoomStatus 向房间内所有玩家推送房间状态（在房间循环中调用，其他协程使用 BroadcastStatus）
 func (room *BattleRoom) BroadcastRoomStatus() {
 	// 通知房间内所有玩家
 	for playerID := range room.Players {
-		room.NotifyRoomStatus(playerID, &pb.RoomDetail{
-			Room: &pb.Room{
-				Id:   room.BattleID,
-				Name: "Battle Room",
-			},
-			CurrentPlayers: room.GetPlayerList(),
-		})
+		room.NotifyRoomStatus(playerID, room.GetRoomDetail())
 	}
 }
 
+// Status 根据房间当前状态计算对外展示的房间状态
+func (room *BattleRoom) Status() pb.RoomStatus {
+	if room.Closed {
+		return pb.RoomStatus_ROOM_STATUS_ENDED
+	}
+	return room.State
+}
+
+// CheckPassword 校验房间密码，公开房间任何密码都可以通过
+func (room *BattleRoom) CheckPassword(password string) bool {
+	return room.Password == "" || room.Password == password
+}
+
+// GetRoomInfo 构造房间概要信息（房间列表、房间详情共用）
+func (room *BattleRoom) GetRoomInfo() *pb.Room {
+	room.PlayersMutex.RLock()
+	defer room.PlayersMutex.RUnlock()
+
+	return &pb.Room{
+		Id:             room.BattleID,
+		Name:           room.Name,
+		MaxPlayers:     MaxRoomPlayers,
+		CurrentPlayers: int32(len(room.Players)),
+		Status:         room.Status(),
+		GameType:       room.GameType.String(),
+		HasPassword:    room.Password != "",
+		CreateTime:     room.CreateTime.UnixMilli(),
+		InviteCode:     room.InviteCode,
+	}
+}
+
+// GetRoomDetail 构造房间详情（房间信息 + 玩家列表）
+func (room *BattleRoom) GetRoomDetail() *pb.RoomDetail {
+	detail := &pb.RoomDetail{
+		Room:           room.GetRoomInfo(),
+		CurrentPlayers: room.GetPlayerList(),
+	}
+
+	room.PlayersMutex.RLock()
+	if !room.StateDeadline.IsZero() {
+		detail.StateDeadline = room.StateDeadline.UnixMilli()
+	}
+	room.PlayersMutex.RUnlock()
+	return detail
+}
+
 func (room *BattleRoom) BroadcastNotifyGameState() {
 	state := room.Game.GetState()
 
@@ -443,44 +603,23 @@ func (room *BattleRoom) BroadcastNotifyGameState() {
 	}
 }
 
-func (room *BattleRoom) NotifyRoomStatus(playerID uint64, msg *pb.RoomDetail) {
-	client, err := room.Server.getGameClient()
-	if err != nil {
-		slog.Error("Failed to get game client", "error", err)
+// notify 通过通知流发送给玩家，入队后立即返回，不阻塞房间
+func (room *BattleRoom) notify(playerID uint64, msgID pb.MessageId, msg proto.Message) {
+	if room.Server == nil || room.Server.Notifier == nil {
 		return
 	}
+	room.Server.Notifier.Notify(room.traceContext(), playerID, msgID, msg)
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
-	notify := &pb.RoomDetailNotify{
-		BeNotifiedUid: playerID,
-		Room:          msg,
-	}
-
-	_, err = client.RoomStatusNotifyRpc(ctx, notify)
-	if err != nil {
-		slog.Error("Failed to send notification", "player_id", playerID, "error", err)
-	}
+func (room *BattleRoom) NotifyRoomStatus(playerID uint64, msg *pb.RoomDetail) {
+	room.notify(playerID, pb.MessageId_ROOM_STATE_NOTIFICATION, msg)
 }
 
 func (room *BattleRoom) NotifyGameState(playerId uint64, msg *pb.GameStateNotify) {
-	client, err := room.Server.getGameClient()
-	if err != nil {
-		slog.Error("Failed to get game client", "error", err)
-		return
-	}
-
 	// 设置被通知者的ID
 	msg.BeNotifiedUid = playerId
 
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
-	_, err = client.GameStateNotifyRpc(ctx, msg)
-	if err != nil {
-		slog.Error("Failed to send game state notification", "player_id", playerId, "error", err)
-	}
+	room.notify(playerId, pb.MessageId_GAME_STATE_NOTIFICATION, msg)
 }
 
 func (room *BattleRoom) GetPlayerList() []*pb.RoomPlayer {
@@ -540,15 +679,6 @@ func (room *BattleRoom) BroadcastPlayerAction(action *pb.GameAction) {
 
 // NotifyPlayerAction 通知单个玩家动作更新（通用方法）
 func (room *BattleRoom) NotifyPlayerAction(playerID uint64, action *pb.GameAction) {
-	client, err := room.Server.getGameClient()
-	if err != nil {
-		slog.Error("Failed to get game client for action update", "error", err)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
 	// 使用PlayerActionNotify消息格式发送动作更新
 	notify := &pb.PlayerActionNotify{
 		BeNotifiedUid: playerID,
@@ -557,12 +687,7 @@ func (room *BattleRoom) NotifyPlayerAction(playerID uint64, action *pb.GameActio
 		Action:        action,
 	}
 
-	_, err = client.PlayerActionNotifyRpc(ctx, notify)
-	if err != nil {
-		slog.Error("Failed to send action notification", "player_id", playerID, "error", err)
-	} else {
-		slog.Debug("Action notification sent successfully", "player_id", playerID)
-	}
+	room.notify(playerID, pb.MessageId_GAME_ACTION_NOTIFICATION, notify)
 }
 
 // NotifyGameStart 通知所有玩家游戏开始
@@ -583,27 +708,7 @@ func (room *BattleRoom) NotifyGameStart() {
 
 // NotifyGameStartToPlayer 通知单个玩家游戏开始
 func (room *BattleRoom) NotifyGameStartToPlayer(playerID uint64, gameStartNotify *pb.GameStartNotification) {
-	client, err := room.Server.getGameClient()
-	if err != nil {
-		slog.Error("Failed to get game client for game start notification", "error", err)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
-	// 使用GameStartNotify消息格式发送游戏开始通知
-	notify := &pb.GameStartNotify{
-		BeNotifiedUid: playerID,
-		GameStart:     gameStartNotify,
-	}
-
-	_, err = client.GameStartNotifyRpc(ctx, notify)
-	if err != nil {
-		slog.Error("Failed to send game start notification", "player_id", playerID, "error", err)
-	} else {
-		slog.Info("Game start notification sent successfully", "player_id", playerID)
-	}
+	room.notify(playerID, pb.MessageId_GAME_START_NOTIFICATION, gameStartNotify)
 }
 
 // BroadcastInitialPositions 广播初始位置信息
@@ -650,7 +755,7 @@ func (room *BattleRoom) BroadcastInitialPositions(newPlayerID uint64) {
 
 // IsGameStarted 实现 RoomInterface 接口，返回游戏是否已开始
 func (room *BattleRoom) IsGameStarted() bool {
-	return room.GameStarted
+	return room.State == pb.RoomStatus_ROOM_STATUS_PLAYING
 }
 
 // BroadcastGameEnd 广播游戏结束通知给所有玩家
@@ -668,60 +773,7 @@ func (room *BattleRoom) BroadcastGameEnd(gameEndNotification *pb.GameEndNotifica
 
 // NotifyGameEndToPlayer 通知单个玩家游戏结束
 func (room *BattleRoom) NotifyGameEndToPlayer(playerID uint64, gameEndNotification *pb.GameEndNotification) {
-	client, err := room.Server.getGameClient()
-	if err != nil {
-		slog.Error("Failed to get game client for game end notification", "error", err)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
-
-	// 使用 GameEndNotify 消息格式发送游戏结束通知
-	notify := &pb.GameEndNotify{
-		BeNotifiedUid: playerID,
-		GameEnd:       gameEndNotification,
-	}
-
-	_, err = client.GameEndNotifyRpc(ctx, notify)
-	if err != nil {
-		slog.Error("Failed to send game end notification", "player_id", playerID, "error", err)
-	} else {
-		slog.Info("Game end notification sent successfully", "player_id", playerID)
-	}
-}
-
-// DestroyRoom 删除房间（只有当房间里没有玩家时才调用）
-func (room *BattleRoom) DestroyRoom() {
-	room.destroyEmptyRoom(room.BattleID)
-}
-
-// destroyEmptyRoom 内部方法，安全地删除空房间
-func (room *BattleRoom) destroyEmptyRoom(roomID string) {
-	slog.Info("Destroying empty room", "room_id", roomID)
-
-	// 加锁确保线程安全
-	room.PlayersMutex.Lock()
-	defer room.PlayersMutex.Unlock()
-
-	// 再次检查房间是否真的为空（双重检查）
-	if len(room.Players) > 0 {
-		slog.Warn("Room not empty during destruction, aborting", "room_id", roomID, "players_count", len(room.Players))
-		return
-	}
-
-	// 确保游戏已结束
-	if room.Game != nil {
-		room.Game.EndGame()
-		room.Game = nil
-	}
-
-	// 从服务器移除房间
-	room.Server.RoomsMutex.Lock()
-	delete(room.Server.BattleRooms, roomID)
-	room.Server.RoomsMutex.Unlock()
-
-	slog.Info("Room destroyed successfully", "room_id", roomID)
+	room.notify(playerID, pb.MessageId_GAME_END_NOTIFICATION, gameEndNotification)
 }
 
 // syncWinCountFromGame 将游戏中的胜利次数同步到房间玩家信息
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"common/tracing"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	pb "proto"
+	"strings"
+)
+
+// ErrRoomClosed 房间已关闭，命令不会再被执行
+var ErrRoomClosed = errors.New("room closed")
+
+// RoomCommand 房间命令
+// 房间状态（玩家、准备状态、游戏实例等）只在房间循环中修改，所有修改都封装为命令投递到房间循环串行执行。
+// 其他协程只能通过 PlayersMutex 读锁读取展示用的房间信息。
+type RoomCommand interface {
+	Execute(room *BattleRoom)
+}
+
+// Submit 将命令投递到房间循环，房间已关闭或 ctx 结束时返回错误
+// ctx 携带追踪上下文时，命令执行和执行中发出的通知归入同一条调用链
+func (room *BattleRoom) Submit(ctx context.Context, cmd RoomCommand) error {
+	if tracing.SpanContextFromContext(ctx).IsValid() {
+		cmd = &tracedCommand{RoomCommand: cmd, ctx: ctx}
+	}
+	select {
+	case room.cmdChan <- cmd:
+		return nil
+	case <-room.done:
+		return ErrRoomClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// request 投递命令并等待房间循环的回复
+func request[T any](ctx context.Context, room *BattleRoom, cmd RoomCommand, reply chan T) (T, error) {
+	var zero T
+	if err := room.Submit(ctx, cmd); err != nil {
+		return zero, err
+	}
+	select {
+	case result := <-reply:
+		return result, nil
+	case <-room.done:
+		// 房间在执行命令时关闭（如 StopCommand 之后），回复可能已经写入
+		select {
+		case result := <-reply:
+			return result, nil
+		default:
+			return zero, ErrRoomClosed
+		}
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// tracedCommand 携带调用方追踪上下文的命令
+type tracedCommand struct {
+	RoomCommand
+	ctx context.Context
+}
+
+func (cmd *tracedCommand) Execute(room *BattleRoom) {
+	name := strings.TrimPrefix(fmt.Sprintf("%T", cmd.RoomCommand), "*main.")
+	ctx, span := tracing.Start(cmd.ctx, "room."+name, tracing.String("room_id", room.BattleID))
+	room.traceCtx = ctx
+	cmd.RoomCommand.Execute(room)
+	room.traceCtx = nil
+	span.End()
+}
+
+// traceContext 当前命令的追踪上下文，不在命令中（定时器、游戏帧）时为空上下文
+func (room *BattleRoom) traceContext() context.Context {
+	if room.traceCtx == nil {
+		return context.Background()
+	}
+	return room.traceCtx
+}
+
+// commandErrorCode 将命令投递失败转换为错误码
+func commandErrorCode(err error) pb.ErrorCode {
+	switch {
+	case errors.Is(err, ErrRoomClosed):
+		return pb.ErrorCode_INVALID_ROOM
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return pb.ErrorCode_TIMEOUT
+	default:
+		return pb.ErrorCode_SERVER_ERROR
+	}
+}
+
+// ====================== 加入房间 ====================== //
+
+type JoinResult struct {
+	Ret  pb.ErrorCode
+	Room *pb.RoomDetail
+}
+
+// JoinCommand 玩家加入房间
+type JoinCommand struct {
+	PlayerID uint64
+	Name     string
+	Password string
+	Reply    chan JoinResult
+}
+
+func (cmd *JoinCommand) Execute(room *BattleRoom) {
+	cmd.Reply <- JoinResult{Ret: room.handleJoin(cmd.PlayerID, cmd.Name, cmd.Password), Room: room.GetRoomDetail()}
+}
+
+// Join 加入房间
+func (room *BattleRoom) Join(ctx context.Context, playerID uint64, name, password string) (JoinResult, error) {
+	reply := make(chan JoinResult, 1)
+	return request(ctx, room, &JoinCommand{PlayerID: playerID, Name: name, Password: password, Reply: reply}, reply)
+}
+
+// ====================== 离开房间 ====================== //
+
+type LeaveResult struct {
+	Ret       pb.ErrorCode
+	Remaining []uint64 // 房间剩余玩家
+}
+
+// LeaveCommand 玩家离开房间
+type LeaveCommand struct {
+	PlayerID uint64
+	Reply    chan LeaveResult
+}
+
+func (cmd *LeaveCommand) Execute(room *BattleRoom) {
+	cmd.Reply <- room.handleLeave(cmd.PlayerID)
+}
+
+// Leave 离开房间
+func (room *BattleRoom) Leave(ctx context.Context, playerID uint64) (LeaveResult, error) {
+	reply := make(chan LeaveResult, 1)
+	return request(ctx, room, &LeaveCommand{PlayerID: playerID, Reply: reply}, reply)
+}
+
+// ====================== 准备 ====================== //
+
+// ReadyCommand 玩家准备/取消准备，全部准备后开始游戏
+type ReadyCommand struct {
+	PlayerID uint64
+	IsReady  bool
+	Reply    chan pb.ErrorCode
+}
+
+func (cmd *ReadyCommand) Execute(room *BattleRoom) {
+	cmd.Reply <- room.handleReady(cmd.PlayerID, cmd.IsReady)
+}
+
+// Ready 设置玩家准备状态
+func (room *BattleRoom) Ready(ctx context.Context, playerID uint64, isReady bool) (pb.ErrorCode, error) {
+	reply := make(chan pb.ErrorCode, 1)
+	return request(ctx, room, &ReadyCommand{PlayerID: playerID, IsReady: isReady, Reply: reply}, reply)
+}
+
+// ====================== 玩家操作 ====================== //
+
+// ActionCommand 玩家游戏操作
+type ActionCommand struct {
+	Command
+	Reply chan pb.ErrorCode
+}
+
+func (cmd *ActionCommand) Execute(room *BattleRoom) {
+	ret := room.HandlePlayerCommandWithResult(cmd.Command)
+	observeAction(cmd.Command.Action, ret)
+	cmd.Reply <- ret
+}
+
+// Action 执行玩家操作
+func (room *BattleRoom) Action(ctx context.Context, playerID uint64, action *pb.GameAction) (pb.ErrorCode, error) {
+	reply := make(chan pb.ErrorCode, 1)
+	cmd := &ActionCommand{Command: Command{PlayerID: playerID, Action: action}, Reply: reply}
+	return request(ctx, room, cmd, reply)
+}
+
+// ====================== 邀请 ====================== //
+
+type InviteResult struct {
+	Ret        pb.ErrorCode
+	Room       *pb.Room
+	InviteCode string
+}
+
+// InviteCommand 校验邀请者并准备邀请码
+type InviteCommand struct {
+	InviterID uint64
+	Reply     chan InviteResult
+}
+
+func (cmd *InviteCommand) Execute(room *BattleRoom) {
+	cmd.Reply <- room.handleInvite(cmd.InviterID)
+}
+
+// PrepareInvite 校验邀请者是否可以邀请，并返回邀请码
+func (room *BattleRoom) PrepareInvite(ctx context.Context, inviterID uint64) (InviteResult, error) {
+	reply := make(chan InviteResult, 1)
+	return request(ctx, room, &InviteCommand{InviterID: inviterID, Reply: reply}, reply)
+}
+
+// ====================== 重新同步 ====================== //
+
+// ResyncCommand 推送完整的房间状态，PlayerID 为 0 表示房间内所有玩家
+type ResyncCommand struct {
+	PlayerID uint64
+	Reply    chan pb.ErrorCode
+}
+
+func (cmd *ResyncCommand) Execute(room *BattleRoom) {
+	if cmd.PlayerID != 0 {
+		if _, exists := room.Players[cmd.PlayerID]; !exists {
+			cmd.Reply <- pb.ErrorCode_NOT_FOUND
+			return
+		}
+	}
+	room.SendFullState(cmd.PlayerID)
+	cmd.Reply <- pb.ErrorCode_OK
+}
+
+// Resync 推送完整的房间状态
+func (room *BattleRoom) Resync(ctx context.Context, playerID uint64) (pb.ErrorCode, error) {
+	reply := make(chan pb.ErrorCode, 1)
+	return request(ctx, room, &ResyncCommand{PlayerID: playerID, Reply: reply}, reply)
+}
+
+// ====================== 广播房间状态 ====================== //
+
+// BroadcastStatusCommand 向房间内所有玩家广播房间状态
+type BroadcastStatusCommand struct{}
+
+func (cmd *BroadcastStatusCommand) Execute(room *BattleRoom) {
+	room.BroadcastRoomStatus()
+}
+
+// BroadcastStatus 投递房间状态广播，不等待执行（房间循环外需要广播时使用）
+func (room *BattleRoom) BroadcastStatus(ctx context.Context) error {
+	return room.Submit(ctx, &BroadcastStatusCommand{})
+}
+
+// ====================== 关闭房间 ====================== //
+
+// StopCommand 结束游戏、释放房间资源并退出房间循环
+type StopCommand struct {
+	Reply chan struct{}
+}
+
+func (cmd *StopCommand) Execute(room *BattleRoom) {
+	room.shutdown()
+	cmd.Reply <- struct{}{}
+}
+
+// Stop 停止战斗房间，房间已关闭时直接返回
+func (room *BattleRoom) Stop(ctx context.Context) error {
+	reply := make(chan struct{}, 1)
+	_, err := request(ctx, room, &StopCommand{Reply: reply}, reply)
+	if errors.Is(err, ErrRoomClosed) {
+		return nil
+	}
+	if err != nil {
+		slog.Warn("Failed to stop room", "room_id", room.BattleID, "error", err)
+	}
+	return err
+}
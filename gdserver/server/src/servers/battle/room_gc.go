@@ -0,0 +1,124 @@
+package main
+
+import (
+	"log/slog"
+	pb "proto"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// EmptyRoomTimeout 房间无人超过该时间后回收，可通过 BATTLE_EMPTY_ROOM_TIMEOUT 配置
+	EmptyRoomTimeout = loadDurationFromEnv("BATTLE_EMPTY_ROOM_TIMEOUT", 30*time.Second)
+	// IdleRoomTimeout 房间在大厅中无任何操作超过该时间后回收，可通过 BATTLE_IDLE_ROOM_TIMEOUT 配置
+	IdleRoomTimeout = loadDurationFromEnv("BATTLE_IDLE_ROOM_TIMEOUT", 10*time.Minute)
+	// RoomGCInterval 房间空闲检查周期
+	RoomGCInterval = 5 * time.Second
+)
+
+// activeRoomLoops 正在运行的房间循环数，与房间表中的房间数对比可以发现泄漏的房间
+var activeRoomLoops atomic.Int64
+
+// touch 记录房间最近一次活动时间（在房间循环中调用）
+func (room *BattleRoom) touch() {
+	room.lastActive = time.Now()
+}
+
+// idleReason 检查房间是否应被回收（在房间循环中调用）
+func (room *BattleRoom) idleReason(now time.Time) (pb.RoomCloseReason, bool) {
+	if len(room.Players) == 0 {
+		if room.emptySince.IsZero() {
+			room.emptySince = now
+		}
+		if now.Sub(room.emptySince) >= EmptyRoomTimeout {
+			return pb.RoomCloseReason_ROOM_CLOSE_EMPTY, true
+		}
+		return pb.RoomCloseReason_ROOM_CLOSE_UNKNOWN, false
+	}
+	room.emptySince = time.Time{}
+
+	// 只回收大厅中的房间，倒计时、游戏和结算由房间状态推进
+	if room.State == pb.RoomStatus_ROOM_STATUS_WAITING && now.Sub(room.lastActive) >= IdleRoomTimeout {
+		return pb.RoomCloseReason_ROOM_CLOSE_IDLE, true
+	}
+	return pb.RoomCloseReason_ROOM_CLOSE_UNKNOWN, false
+}
+
+// collect 回收空闲房间：通知剩余玩家、关闭房间并从服务器移除（在房间循环中调用）
+func (room *BattleRoom) collect(reason pb.RoomCloseReason) {
+	slog.Info("Collecting idle room", "room_id", room.BattleID, "reason", reason, "players", len(room.Players),
+		"idle", time.Since(room.lastActive))
+	room.closeWithNotice(reason)
+}
+
+// closeWithNotice 通知剩余玩家房间关闭原因，关闭房间并从服务器移除（在房间循环中调用）
+func (room *BattleRoom) closeWithNotice(reason pb.RoomCloseReason) {
+	players := make([]uint64, 0, len(room.Players))
+	for playerID := range room.Players {
+		players = append(players, playerID)
+		room.notify(playerID, pb.MessageId_ROOM_CLOSED_NOTIFICATION, &pb.RoomClosedNotification{
+			RoomId: room.BattleID,
+			Reason: reason,
+		})
+	}
+
+	room.shutdown()
+	room.Server.forgetRoom(room, players)
+}
+
+// forgetRoom 从房间表和玩家映射中移除已关闭的房间
+func (s *BattleServer) forgetRoom(room *BattleRoom, players []uint64) {
+	if s == nil {
+		return
+	}
+
+	s.RoomsMutex.Lock()
+	if s.BattleRooms[room.BattleID] == room {
+		delete(s.BattleRooms, room.BattleID)
+	}
+	s.RoomsMutex.Unlock()
+
+	s.forgetPlayers(room.BattleID, players)
+}
+
+// forgetPlayers 移除玩家到房间的映射（玩家被移出房间或房间关闭）
+func (s *BattleServer) forgetPlayers(roomID string, players []uint64) {
+	if s == nil {
+		return
+	}
+
+	s.PlayersMutex.Lock()
+	for _, playerID := range players {
+		if s.PlayerInRoom[playerID] == roomID {
+			delete(s.PlayerInRoom, playerID)
+		}
+	}
+	s.PlayersMutex.Unlock()
+}
+
+// stopRooms 停止所有房间循环（服务器退出时调用），房间快照保留以便重启后恢复
+func (s *BattleServer) stopRooms() {
+	rooms := s.roomList()
+	for _, room := range rooms {
+		room.cancel()
+	}
+	for _, room := range rooms {
+		<-room.done
+	}
+	slog.Info("All room loops stopped", "rooms", len(rooms))
+}
+
+// logStats 输出房间数和协程数，用于观察房间和协程是否泄漏
+func (s *BattleServer) logStats() {
+	s.RoomsMutex.RLock()
+	roomCount := len(s.BattleRooms)
+	s.RoomsMutex.RUnlock()
+
+	s.PlayersMutex.RLock()
+	playerCount := len(s.PlayerInRoom)
+	s.PlayersMutex.RUnlock()
+
+	slog.Info("Battle server stats", "rooms", roomCount, "room_loops", activeRoomLoops.Load(),
+		"players", playerCount, "goroutines", runtime.NumGoroutine())
+}
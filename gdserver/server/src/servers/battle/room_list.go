@@ -0,0 +1,102 @@
+package main
+
+import (
+	"common/roomlist"
+	pb "proto"
+	"sort"
+)
+
+// roomListEntry 房间列表排序用的快照，避免排序过程中房间状态变化
+type roomListEntry struct {
+	info *pb.Room
+	key  roomlist.Cursor
+}
+
+// matchRoomFilter 判断房间是否满足过滤条件
+func matchRoomFilter(info *pb.Room, filter *pb.GetRoomListRequest) bool {
+	if filter.GetJoinableOnly() {
+		if info.Status != pb.RoomStatus_ROOM_STATUS_WAITING || info.CurrentPlayers >= info.MaxPlayers {
+			return false
+		}
+	}
+	if filter.GetNotStartedOnly() && info.Status != pb.RoomStatus_ROOM_STATUS_WAITING &&
+		info.Status != pb.RoomStatus_ROOM_STATUS_COUNTDOWN {
+		return false
+	}
+	if filter.GetGameType() != "" && info.GameType != filter.GetGameType() {
+		return false
+	}
+	if filter.HasPassword != nil && info.HasPassword != filter.GetHasPassword() {
+		return false
+	}
+	return true
+}
+
+// sortKey 根据排序方式生成房间的排序键
+func sortKey(info *pb.Room, order pb.RoomSortOrder) roomlist.Cursor {
+	return roomlist.NewCursor(order == pb.RoomSortOrder_ROOM_SORT_FULLEST, info.CreateTime, info.CurrentPlayers, info.Id)
+}
+
+// ListRooms 按过滤条件、排序方式和游标返回一页房间，以及下一页的游标
+func (s *BattleServer) ListRooms(filter *pb.GetRoomListRequest) ([]*pb.Room, string, error) {
+	if filter == nil {
+		filter = &pb.GetRoomListRequest{}
+	}
+
+	var after *roomlist.Cursor
+	if filter.GetCursor() != "" {
+		c, err := roomlist.Decode(filter.GetCursor())
+		if err != nil {
+			return nil, "", err
+		}
+		after = &c
+	}
+
+	pageSize := int(filter.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = roomlist.DefaultPageSize
+	}
+	if pageSize > roomlist.MaxPageSize {
+		pageSize = roomlist.MaxPageSize
+	}
+
+	// 先在读锁内拍快照，排序和分页在锁外进行
+	s.RoomsMutex.RLock()
+	entries := make([]roomListEntry, 0, len(s.BattleRooms))
+	for _, room := range s.BattleRooms {
+		info := room.GetRoomInfo()
+		if !matchRoomFilter(info, filter) {
+			continue
+		}
+		entries = append(entries, roomListEntry{info: info, key: sortKey(info, filter.GetSort())})
+	}
+	s.RoomsMutex.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key.Before(entries[j].key)
+	})
+
+	start := 0
+	if after != nil {
+		start = sort.Search(len(entries), func(i int) bool {
+			return after.Before(entries[i].key)
+		})
+	}
+
+	end := start + pageSize
+	if end > len(entries) {
+		end = len(entries)
+	}
+
+	rooms := make([]*pb.Room, 0, end-start)
+	for _, e := range entries[start:end] {
+		rooms = append(rooms, e.info)
+	}
+
+	nextCursor := ""
+	if end < len(entries) {
+		nextCursor = entries[end-1].key.Encode()
+	}
+
+	return rooms, nextCursor, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"time"
+)
+
+// signalWake 时间轮到期回调，唤醒房间循环（不阻塞时间轮协程，多次唤醒合并为一次）
+func (room *BattleRoom) signalWake() {
+	select {
+	case room.wake <- struct{}{}:
+	default:
+	}
+}
+
+// nextWakeup 房间下一次需要被唤醒的时间，零值表示无需唤醒（在房间循环中调用）
+// 需要定时处理的事项：倒计时/结算结束、游戏登记的更新时间（回合超时、帧率）、快照写入、空闲检查
+func (room *BattleRoom) nextWakeup() time.Time {
+	next := room.nextGC
+	earliest := func(t time.Time) {
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	earliest(room.StateDeadline)
+	if room.IsGameStarted() && room.Game != nil {
+		earliest(room.Game.NextUpdate())
+	}
+
+	// 状态有变化时在一个快照周期后写入
+	if room.dirty.Load() && room.nextSnapshot.IsZero() {
+		room.nextSnapshot = time.Now().Add(RoomSnapshotInterval)
+	}
+	earliest(room.nextSnapshot)
+	return next
+}
+
+// scheduleWakeup 按 nextWakeup 在共享时间轮中登记唤醒，唤醒时间未变化时不重复登记（在房间循环中调用）
+func (room *BattleRoom) scheduleWakeup() {
+	next := room.nextWakeup()
+	if room.wakeTimer != nil && !room.wakeTimer.Fired() && next.Equal(room.wakeAt) {
+		return
+	}
+
+	room.cancelWakeup()
+	if next.IsZero() {
+		return
+	}
+	room.wakeAt = next
+	room.wakeTimer = roomScheduler.Schedule(next, room.signalWake)
+}
+
+// cancelWakeup 取消已登记的唤醒（在房间循环中调用）
+func (room *BattleRoom) cancelWakeup() {
+	if room.wakeTimer != nil {
+		room.wakeTimer.Cancel()
+		room.wakeTimer = nil
+	}
+	room.wakeAt = time.Time{}
+}
+
+// onWakeup 处理到期的定时事项（在房间循环中调用）
+func (room *BattleRoom) onWakeup(now time.Time) {
+	room.tickState(now)
+	if room.updateGame(); room.Closed {
+		return
+	}
+
+	if !room.nextSnapshot.IsZero() && !now.Before(room.nextSnapshot) {
+		room.nextSnapshot = time.Time{}
+		room.saveSnapshotIfDirty()
+	}
+
+	if !now.Before(room.nextGC) {
+		room.nextGC = now.Add(RoomGCInterval)
+		room.kickIdleLobbyPlayers(now)
+		if reason, idle := room.idleReason(now); idle {
+			room.collect(reason)
+		}
+	}
+}
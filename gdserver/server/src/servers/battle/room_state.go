@@ -0,0 +1,106 @@
+package main
+
+import (
+	"log/slog"
+	pb "proto"
+	"time"
+)
+
+var (
+	// GameCountdownDuration 全部准备后到开局的倒计时，可通过 BATTLE_GAME_COUNTDOWN 配置
+	GameCountdownDuration = loadDurationFromEnv("BATTLE_GAME_COUNTDOWN", 5*time.Second)
+	// SettlementDuration 游戏结束后展示结算的时间，可通过 BATTLE_SETTLEMENT_DURATION 配置
+	SettlementDuration = loadDurationFromEnv("BATTLE_SETTLEMENT_DURATION", 5*time.Second)
+	// LobbyIdleKickTimeout 大厅中一直未准备的玩家超过该时间后移出房间，可通过 BATTLE_LOBBY_IDLE_TIMEOUT 配置
+	LobbyIdleKickTimeout = loadDurationFromEnv("BATTLE_LOBBY_IDLE_TIMEOUT", 2*time.Minute)
+)
+
+// setState 切换房间状态，deadline 为倒计时/结算的结束时间（在房间循环中调用）
+func (room *BattleRoom) setState(state pb.RoomStatus, deadline time.Time) {
+	room.PlayersMutex.Lock()
+	from := room.State
+	room.State = state
+	room.StateDeadline = deadline
+	room.PlayersMutex.Unlock()
+	room.markDirty()
+
+	slog.Info("Room state changed", "room_id", room.BattleID, "from", from, "to", state)
+}
+
+// canStartCountdown 房间人数大于等于2人且全部准备
+func (room *BattleRoom) canStartCountdown() bool {
+	return len(room.Players) >= 2 && room.AllPlayersReady()
+}
+
+// updateCountdown 根据准备状态开始或取消开局倒计时（在房间循环中调用）
+func (room *BattleRoom) updateCountdown() {
+	switch {
+	case room.State == pb.RoomStatus_ROOM_STATUS_WAITING && room.canStartCountdown():
+		slog.Info("All players ready, starting countdown", "room_id", room.BattleID, "countdown", GameCountdownDuration)
+		room.setState(pb.RoomStatus_ROOM_STATUS_COUNTDOWN, time.Now().Add(GameCountdownDuration))
+	case room.State == pb.RoomStatus_ROOM_STATUS_COUNTDOWN && !room.canStartCountdown():
+		slog.Info("Countdown cancelled", "room_id", room.BattleID, "players", len(room.Players))
+		room.setState(pb.RoomStatus_ROOM_STATUS_WAITING, time.Time{})
+	}
+}
+
+// tickState 推进倒计时和结算（在房间循环中调用）
+func (room *BattleRoom) tickState(now time.Time) {
+	if room.StateDeadline.IsZero() || now.Before(room.StateDeadline) {
+		return
+	}
+
+	switch room.State {
+	case pb.RoomStatus_ROOM_STATUS_COUNTDOWN:
+		slog.Info("Countdown finished, starting game", "room_id", room.BattleID)
+		// 通知所有玩家游戏开始
+		room.NotifyGameStart()
+		room.StartGame()
+	case pb.RoomStatus_ROOM_STATUS_SETTLEMENT:
+		room.setState(pb.RoomStatus_ROOM_STATUS_WAITING, time.Time{})
+		// 回到大厅后重新计算未准备时间
+		for _, info := range room.Players {
+			info.idleSince = now
+		}
+		room.BroadcastRoomStatus()
+	}
+}
+
+// kickIdleLobbyPlayers 将大厅中长时间未准备的玩家移出房间（在房间循环中调用）
+func (room *BattleRoom) kickIdleLobbyPlayers(now time.Time) {
+	if room.State != pb.RoomStatus_ROOM_STATUS_WAITING {
+		return
+	}
+
+	var kicked []uint64
+	for playerID, info := range room.Players {
+		if room.ReadyPlayers[playerID] {
+			continue
+		}
+		if info.idleSince.IsZero() {
+			// 从快照恢复的玩家从恢复时开始计时
+			info.idleSince = now
+			continue
+		}
+		if now.Sub(info.idleSince) >= LobbyIdleKickTimeout {
+			kicked = append(kicked, playerID)
+		}
+	}
+	if len(kicked) == 0 {
+		return
+	}
+
+	for _, playerID := range kicked {
+		slog.Info("Kicking idle lobby player", "room_id", room.BattleID, "player_id", playerID)
+		room.RemovePlayer(playerID)
+		room.notify(playerID, pb.MessageId_KICKED_FROM_ROOM_NOTIFICATION, &pb.KickedFromRoomNotification{
+			RoomId: room.BattleID,
+			Reason: pb.KickReason_KICK_LOBBY_IDLE,
+		})
+	}
+	room.Server.forgetPlayers(room.BattleID, kicked)
+
+	// 剩余玩家可能已经全部准备
+	room.updateCountdown()
+	room.BroadcastRoomStatus()
+}
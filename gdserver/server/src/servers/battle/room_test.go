@@ -0,0 +1,543 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	pb "proto"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 房间测试使用的最小词牌配置
+const testDeck = `[
+	{"word": "今天", "pos": "Adv-TIME-DATE"},
+	{"word": "我", "pos": "Pron"},
+	{"word": "吃", "pos": "V"},
+	{"word": "苹果", "pos": "N"}
+]`
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "battle-test")
+	if err != nil {
+		panic(err)
+	}
+	WordCardDeckFile = filepath.Join(dir, "word_cards.json")
+	if err := os.WriteFile(WordCardDeckFile, []byte(testDeck), 0o644); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// roomFunc 在房间循环中执行任意函数
+type roomFunc func(room *BattleRoom)
+
+func (f roomFunc) Execute(room *BattleRoom) { f(room) }
+
+func newTestRoom(t *testing.T) *BattleRoom {
+	t.Helper()
+	room := NewBattleRoom("test-room", nil, GameType_WordCardGame)
+	room.Run()
+	t.Cleanup(func() {
+		room.Stop(context.Background())
+	})
+	return room
+}
+
+func moveAction(playerID uint64, x, y int32) *pb.GameAction {
+	return &pb.GameAction{
+		PlayerId:   playerID,
+		ActionType: pb.ActionType_CHAR_MOVE,
+		ActionDetail: &pb.GameAction_CharMove{
+			CharMove: &pb.CharacterMoveAction{ToX: x, ToY: y},
+		},
+	}
+}
+
+func TestRoomJoinRespectsCapacity(t *testing.T) {
+	room := newTestRoom(t)
+	ctx := context.Background()
+
+	// 并发加入的玩家数超过房间上限，成功的人数必须等于上限
+	const joiners = MaxRoomPlayers * 3
+	results := make([]pb.ErrorCode, joiners)
+	var wg sync.WaitGroup
+	for i := 0; i < joiners; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			result, err := room.Join(ctx, uint64(i+1), "player", "")
+			if err != nil {
+				t.Errorf("join %d: %v", i, err)
+				return
+			}
+			results[i] = result.Ret
+		}(i)
+	}
+	wg.Wait()
+
+	joined := 0
+	var member uint64
+	for i, ret := range results {
+		switch ret {
+		case pb.ErrorCode_OK:
+			joined++
+			member = uint64(i + 1)
+		case pb.ErrorCode_ROOM_FULL:
+		default:
+			t.Errorf("unexpected join result %v", ret)
+		}
+	}
+	if joined != MaxRoomPlayers {
+		t.Fatalf("joined = %d, want %d", joined, MaxRoomPlayers)
+	}
+	if info := room.GetRoomInfo(); int(info.CurrentPlayers) != MaxRoomPlayers {
+		t.Fatalf("CurrentPlayers = %d, want %d", info.CurrentPlayers, MaxRoomPlayers)
+	}
+
+	// 重复加入
+	result, err := room.Join(ctx, member, "player", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Ret != pb.ErrorCode_PLAYER_ALREADY_IN_ROOM {
+		t.Fatalf("rejoin ret = %v, want PLAYER_ALREADY_IN_ROOM", result.Ret)
+	}
+}
+
+func TestRoomConcurrentCommandsAndReads(t *testing.T) {
+	room := newTestRoom(t)
+	ctx := context.Background()
+
+	const players = 2
+	for id := uint64(1); id <= players; id++ {
+		if result, err := room.Join(ctx, id, "player", ""); err != nil || result.Ret != pb.ErrorCode_OK {
+			t.Fatalf("join %d: ret=%v err=%v", id, result.Ret, err)
+		}
+	}
+
+	stop := make(chan struct{})
+	var readers sync.WaitGroup
+	// 读协程：模拟房间列表、房间信息查询
+	for i := 0; i < 4; i++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				room.GetRoomInfo()
+				room.GetRoomDetail()
+			}
+		}()
+	}
+
+	// 写协程：移动、准备/取消准备、进出房间
+	var writers sync.WaitGroup
+	for id := uint64(1); id <= players; id++ {
+		writers.Add(1)
+		go func(id uint64) {
+			defer writers.Done()
+			for i := 0; i < 50; i++ {
+				if _, err := room.Action(ctx, id, moveAction(id, int32(i), int32(i))); err != nil {
+					t.Errorf("action: %v", err)
+					return
+				}
+				if _, err := room.Ready(ctx, id, i%2 == 0); err != nil {
+					t.Errorf("ready: %v", err)
+					return
+				}
+			}
+		}(id)
+	}
+	writers.Add(1)
+	go func() {
+		defer writers.Done()
+		const guest = 100
+		for i := 0; i < 50; i++ {
+			if _, err := room.Join(ctx, guest, "guest", ""); err != nil {
+				t.Errorf("join: %v", err)
+				return
+			}
+			if _, err := room.Leave(ctx, guest); err != nil {
+				t.Errorf("leave: %v", err)
+				return
+			}
+		}
+	}()
+	writers.Wait()
+	close(stop)
+	readers.Wait()
+
+	if _, err := room.Resync(ctx, 1); err != nil {
+		t.Fatalf("resync: %v", err)
+	}
+}
+
+// withStateDurations 缩短倒计时、结算和大厅移出时间，测试结束后恢复
+func withStateDurations(t *testing.T, countdown, settlement, lobbyIdle time.Duration) {
+	t.Helper()
+	oldCountdown, oldSettlement, oldLobbyIdle := GameCountdownDuration, SettlementDuration, LobbyIdleKickTimeout
+	GameCountdownDuration, SettlementDuration, LobbyIdleKickTimeout = countdown, settlement, lobbyIdle
+	t.Cleanup(func() {
+		GameCountdownDuration, SettlementDuration, LobbyIdleKickTimeout = oldCountdown, oldSettlement, oldLobbyIdle
+	})
+}
+
+// waitStatus 等待房间进入指定状态
+func waitStatus(t *testing.T, room *BattleRoom, want pb.RoomStatus) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if room.GetRoomInfo().Status == want {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("status = %v, want %v", room.GetRoomInfo().Status, want)
+}
+
+func joinPlayers(t *testing.T, room *BattleRoom, ids ...uint64) {
+	t.Helper()
+	for _, id := range ids {
+		if result, err := room.Join(context.Background(), id, "player", ""); err != nil || result.Ret != pb.ErrorCode_OK {
+			t.Fatalf("join %d: ret=%v err=%v", id, result.Ret, err)
+		}
+	}
+}
+
+func TestRoomCountdownThenStartGame(t *testing.T) {
+	withStateDurations(t, 50*time.Millisecond, time.Hour, time.Hour)
+	room := newTestRoom(t)
+	ctx := context.Background()
+	joinPlayers(t, room, 1, 2)
+
+	var wg sync.WaitGroup
+	for id := uint64(1); id <= 2; id++ {
+		wg.Add(1)
+		go func(id uint64) {
+			defer wg.Done()
+			if ret, err := room.Ready(ctx, id, true); err != nil || ret != pb.ErrorCode_OK {
+				t.Errorf("ready %d: ret=%v err=%v", id, ret, err)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	// 全部准备后先进入倒计时，倒计时期间不允许加入
+	detail := room.GetRoomDetail()
+	if detail.Room.Status != pb.RoomStatus_ROOM_STATUS_COUNTDOWN {
+		t.Fatalf("status = %v, want COUNTDOWN", detail.Room.Status)
+	}
+	if detail.StateDeadline == 0 {
+		t.Fatal("countdown deadline not set")
+	}
+	if result, err := room.Join(ctx, 3, "late", ""); err != nil || result.Ret != pb.ErrorCode_INVALID_STATE {
+		t.Fatalf("join during countdown: ret=%v err=%v", result.Ret, err)
+	}
+
+	waitStatus(t, room, pb.RoomStatus_ROOM_STATUS_PLAYING)
+
+	// 游戏进行中不允许准备
+	if ret, err := room.Ready(ctx, 1, false); err != nil || ret != pb.ErrorCode_INVALID_STATE {
+		t.Fatalf("ready during game: ret=%v err=%v", ret, err)
+	}
+}
+
+func TestRoomCountdownCancelled(t *testing.T) {
+	withStateDurations(t, time.Hour, time.Hour, time.Hour)
+	room := newTestRoom(t)
+	ctx := context.Background()
+	joinPlayers(t, room, 1, 2, 3)
+
+	for id := uint64(1); id <= 3; id++ {
+		room.Ready(ctx, id, true)
+	}
+	waitStatus(t, room, pb.RoomStatus_ROOM_STATUS_COUNTDOWN)
+
+	// 取消准备回到等待中
+	room.Ready(ctx, 2, false)
+	waitStatus(t, room, pb.RoomStatus_ROOM_STATUS_WAITING)
+	if deadline := room.GetRoomDetail().StateDeadline; deadline != 0 {
+		t.Fatalf("deadline = %d after cancel, want 0", deadline)
+	}
+
+	// 重新准备后再次倒计时，有人离开导致人数不足时取消
+	room.Ready(ctx, 2, true)
+	waitStatus(t, room, pb.RoomStatus_ROOM_STATUS_COUNTDOWN)
+	room.Leave(ctx, 3)
+	waitStatus(t, room, pb.RoomStatus_ROOM_STATUS_COUNTDOWN)
+	room.Leave(ctx, 2)
+	waitStatus(t, room, pb.RoomStatus_ROOM_STATUS_WAITING)
+}
+
+func TestRoomSettlementReturnsToWaiting(t *testing.T) {
+	withStateDurations(t, time.Hour, 30*time.Millisecond, time.Hour)
+	room := newTestRoom(t)
+	joinPlayers(t, room, 1, 2)
+
+	// 直接在房间循环中结束游戏，进入结算
+	done := make(chan struct{})
+	room.Submit(context.Background(), roomFunc(func(room *BattleRoom) {
+		room.StartGame()
+		room.EndGame()
+		close(done)
+	}))
+	<-done
+
+	if status := room.GetRoomInfo().Status; status != pb.RoomStatus_ROOM_STATUS_SETTLEMENT {
+		t.Fatalf("status = %v, want SETTLEMENT", status)
+	}
+	waitStatus(t, room, pb.RoomStatus_ROOM_STATUS_WAITING)
+}
+
+func TestRoomKicksIdleLobbyPlayers(t *testing.T) {
+	withStateDurations(t, time.Hour, time.Hour, 40*time.Millisecond)
+	oldInterval := RoomGCInterval
+	RoomGCInterval = 10 * time.Millisecond
+	defer func() { RoomGCInterval = oldInterval }()
+
+	room := newTestRoom(t)
+	ctx := context.Background()
+	joinPlayers(t, room, 1, 2)
+	room.Ready(ctx, 1, true)
+
+	// 未准备的玩家被移出，已准备的玩家保留
+	deadline := time.Now().Add(2 * time.Second)
+	for room.GetRoomInfo().CurrentPlayers != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("CurrentPlayers = %d, want 1", room.GetRoomInfo().CurrentPlayers)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	players := room.GetPlayerList()
+	if len(players) != 1 || players[0].Uid != 1 {
+		t.Fatalf("remaining players = %v, want [1]", players)
+	}
+}
+
+func TestRoomStop(t *testing.T) {
+	room := NewBattleRoom("stopped-room", nil, GameType_WordCardGame)
+	room.Run()
+	ctx := context.Background()
+
+	if result, err := room.Join(ctx, 1, "player", ""); err != nil || result.Ret != pb.ErrorCode_OK {
+		t.Fatalf("join: ret=%v err=%v", result.Ret, err)
+	}
+
+	// 并发停止，只有一个会真正执行
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := room.Stop(ctx); err != nil {
+				t.Errorf("stop: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-room.done:
+	case <-time.After(time.Second):
+		t.Fatal("room loop did not exit")
+	}
+
+	if status := room.GetRoomInfo().Status; status != pb.RoomStatus_ROOM_STATUS_ENDED {
+		t.Fatalf("status = %v, want ENDED", status)
+	}
+	if _, err := room.Join(ctx, 2, "player", ""); !errors.Is(err, ErrRoomClosed) {
+		t.Fatalf("join after stop: err=%v, want ErrRoomClosed", err)
+	}
+	if code := commandErrorCode(ErrRoomClosed); code != pb.ErrorCode_INVALID_ROOM {
+		t.Fatalf("commandErrorCode = %v, want INVALID_ROOM", code)
+	}
+}
+
+func TestRoomSubmitHonoursContext(t *testing.T) {
+	room := NewBattleRoom("idle-room", nil, GameType_WordCardGame)
+	// 房间循环未启动，命令无人处理，请求应在 ctx 超时后返回
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := room.Resync(ctx, 0)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	if code := commandErrorCode(err); code != pb.ErrorCode_TIMEOUT {
+		t.Fatalf("commandErrorCode = %v, want TIMEOUT", code)
+	}
+}
+
+func TestRoomCollectedWhenIdle(t *testing.T) {
+	oldEmpty, oldIdle, oldInterval := EmptyRoomTimeout, IdleRoomTimeout, RoomGCInterval
+	EmptyRoomTimeout, IdleRoomTimeout, RoomGCInterval = 30*time.Millisecond, 60*time.Millisecond, 10*time.Millisecond
+	defer func() {
+		EmptyRoomTimeout, IdleRoomTimeout, RoomGCInterval = oldEmpty, oldIdle, oldInterval
+	}()
+
+	baseline := activeRoomLoops.Load()
+
+	empty := NewBattleRoom("empty-room", nil, GameType_WordCardGame)
+	empty.Run()
+
+	idle := NewBattleRoom("idle-lobby", nil, GameType_WordCardGame)
+	idle.Run()
+	if result, err := idle.Join(context.Background(), 1, "player", ""); err != nil || result.Ret != pb.ErrorCode_OK {
+		t.Fatalf("join: ret=%v err=%v", result.Ret, err)
+	}
+
+	for _, room := range []*BattleRoom{empty, idle} {
+		select {
+		case <-room.done:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("room %s was not collected", room.BattleID)
+		}
+		if status := room.GetRoomInfo().Status; status != pb.RoomStatus_ROOM_STATUS_ENDED {
+			t.Fatalf("room %s status = %v, want ENDED", room.BattleID, status)
+		}
+	}
+
+	if loops := activeRoomLoops.Load(); loops != baseline {
+		t.Fatalf("active room loops = %d, want %d", loops, baseline)
+	}
+}
+
+func TestRoomContextCancelStopsLoop(t *testing.T) {
+	room := NewBattleRoom("cancelled-room", nil, GameType_WordCardGame)
+	room.Run()
+
+	room.cancel()
+	select {
+	case <-room.done:
+	case <-time.After(time.Second):
+		t.Fatal("room loop did not exit after cancel")
+	}
+
+	// 取消只停止循环，不关闭房间（快照保留用于重启恢复）
+	if room.Closed {
+		t.Fatal("cancelled room should not be marked closed")
+	}
+	if _, err := room.Resync(context.Background(), 0); !errors.Is(err, ErrRoomClosed) {
+		t.Fatalf("resync after cancel: err=%v, want ErrRoomClosed", err)
+	}
+}
+
+func TestServerDrain(t *testing.T) {
+	oldTimeout := DrainTimeout
+	DrainTimeout = 100 * time.Millisecond
+	defer func() { DrainTimeout = oldTimeout }()
+
+	s := &BattleServer{
+		BattleRooms:  make(map[string]*BattleRoom),
+		PlayerInRoom: make(map[uint64]string),
+	}
+	ctx := context.Background()
+
+	addRoom := func(id string, players ...uint64) *BattleRoom {
+		room := NewBattleRoom(id, s, GameType_WordCardGame)
+		room.Run()
+		joinPlayers(t, room, players...)
+		s.BattleRooms[id] = room
+		for _, playerID := range players {
+			s.PlayerInRoom[playerID] = id
+		}
+		return room
+	}
+
+	lobby := addRoom("lobby", 1)
+	playing := addRoom("playing", 2, 3)
+	started := make(chan struct{})
+	playing.Submit(ctx, roomFunc(func(room *BattleRoom) {
+		room.StartGame()
+		close(started)
+	}))
+	<-started
+
+	s.Drain(ctx)
+
+	for _, room := range []*BattleRoom{lobby, playing} {
+		select {
+		case <-room.done:
+		case <-time.After(time.Second):
+			t.Fatalf("room %s not closed after drain", room.BattleID)
+		}
+	}
+	if n := s.roomCount(); n != 0 {
+		t.Fatalf("rooms after drain = %d, want 0", n)
+	}
+	if n := len(s.PlayerInRoom); n != 0 {
+		t.Fatalf("players after drain = %d, want 0", n)
+	}
+
+	resp, err := s.CreateRoomRpc(ctx, &pb.CreateRoomRpcRequest{Player: &pb.PlayerInitData{PlayerId: 9}})
+	if err != nil || resp.Ret != pb.ErrorCode_SERVER_MAINTENANCE {
+		t.Fatalf("create while draining: ret=%v err=%v", resp.GetRet(), err)
+	}
+}
+
+// TestMatchRoomCreationWithConcurrentJoins 匹配房间启动后的状态广播和并发的加入、离开都在房间循环中执行（配合 -race）
+func TestMatchRoomCreationWithConcurrentJoins(t *testing.T) {
+	s := &BattleServer{
+		BattleRooms:  make(map[string]*BattleRoom),
+		PlayerInRoom: make(map[uint64]string),
+		Notifier:     NewGameNotifier(nil),
+	}
+	ctx := context.Background()
+	const roomID = "match-room"
+
+	// 房间一发布就有其他玩家反复加入、离开
+	var joiners sync.WaitGroup
+	for id := uint64(10); id < 13; id++ {
+		joiners.Add(1)
+		go func(id uint64) {
+			defer joiners.Done()
+			for i := 0; i < 20; {
+				room, exists := s.getRoom(roomID)
+				if !exists {
+					runtime.Gosched()
+					continue
+				}
+				if result, err := room.Join(ctx, id, "joiner", ""); err == nil && result.Ret == pb.ErrorCode_OK {
+					room.Leave(ctx, id)
+				}
+				i++
+			}
+		}(id)
+	}
+
+	players := []*pb.PlayerInitData{{PlayerId: 1, PlayerName: "p1"}, {PlayerId: 2, PlayerName: "p2"}}
+	resp := s.createMatchRoom(ctx, roomID, players, []uint64{1, 2})
+	if resp.Ret != pb.ErrorCode_OK || len(resp.Room.GetCurrentPlayers()) != 2 {
+		t.Fatalf("create match room: ret=%v room=%v", resp.Ret, resp.Room)
+	}
+	room, _ := s.getRoom(roomID)
+	defer room.Stop(ctx)
+	joiners.Wait()
+
+	// 等待已投递的命令（包括广播）执行完
+	barrier := make(chan struct{})
+	room.Submit(ctx, roomFunc(func(*BattleRoom) { close(barrier) }))
+	<-barrier
+
+	// 匹配到的玩家都收到了房间状态
+	notified := make(map[uint64]bool)
+	for len(s.Notifier.queue) > 0 {
+		if n := <-s.Notifier.queue; n.MsgId == pb.MessageId_ROOM_STATE_NOTIFICATION {
+			notified[n.BeNotifiedUid] = true
+		}
+	}
+	if !notified[1] || !notified[2] {
+		t.Fatalf("room status sent to %v, want players 1 and 2", notified)
+	}
+}
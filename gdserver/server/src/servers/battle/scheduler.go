@@ -0,0 +1,133 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	SchedulerTick  = 10 * time.Millisecond // 时间轮精度
+	schedulerSlots = 1024                  // 时间轮槽数，一圈约 10 秒
+)
+
+// roomScheduler 所有房间共享的定时器，房间只在需要时（倒计时、结算、游戏回合、快照、空闲检查）登记唤醒时间，
+// 避免每个房间各自持有高频 Ticker
+var roomScheduler = NewScheduler(SchedulerTick, schedulerSlots)
+
+// Scheduler 哈希时间轮，由单个协程推进
+// 到期回调在时间轮协程中执行，必须立即返回（通常只是向房间循环发送唤醒信号）
+type Scheduler struct {
+	tick    time.Duration
+	mu      sync.Mutex
+	slots   [][]*Timer
+	cursor  int
+	pending atomic.Int64 // 已登记且未触发的定时器数（包含已取消但尚未清理的）
+	stopCh  chan struct{}
+}
+
+// Timer 时间轮中的定时器
+type Timer struct {
+	fn        func()
+	rounds    int // 还需要转过的整圈数
+	cancelled atomic.Bool
+	fired     atomic.Bool
+}
+
+// Cancel 取消定时器，已经触发的定时器取消无效
+func (t *Timer) Cancel() {
+	t.cancelled.Store(true)
+}
+
+// Fired 定时器是否已经触发
+func (t *Timer) Fired() bool {
+	return t.fired.Load()
+}
+
+// NewScheduler 创建并启动时间轮
+func NewScheduler(tick time.Duration, slots int) *Scheduler {
+	s := &Scheduler{
+		tick:   tick,
+		slots:  make([][]*Timer, slots),
+		stopCh: make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Schedule 登记在 at 时刻之后执行的回调（最多延迟两个 tick），at 已过期时尽快执行
+func (s *Scheduler) Schedule(at time.Time, fn func()) *Timer {
+	// 当前格已经走过一部分，多等一格保证不会提前触发
+	ticks := int((time.Until(at)+s.tick-1)/s.tick) + 1
+	if ticks < 1 {
+		ticks = 1
+	}
+
+	t := &Timer{fn: fn, rounds: (ticks - 1) / len(s.slots)}
+
+	s.mu.Lock()
+	slot := (s.cursor + ticks) % len(s.slots)
+	s.slots[slot] = append(s.slots[slot], t)
+	s.mu.Unlock()
+
+	s.pending.Add(1)
+	return t
+}
+
+// Pending 已登记且未触发的定时器数
+func (s *Scheduler) Pending() int64 {
+	return s.pending.Load()
+}
+
+// Stop 停止时间轮，未触发的定时器不再执行
+func (s *Scheduler) Stop() {
+	close(s.stopCh)
+}
+
+func (s *Scheduler) run() {
+	ticker := time.NewTicker(s.tick)
+	defer ticker.Stop()
+
+	var due []*Timer
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			due = s.advance(due[:0])
+			for _, t := range due {
+				t.fn()
+			}
+			clear(due)
+		}
+	}
+}
+
+// advance 推进一格，返回到期的定时器
+func (s *Scheduler) advance(due []*Timer) []*Timer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cursor = (s.cursor + 1) % len(s.slots)
+	timers := s.slots[s.cursor]
+	if len(timers) == 0 {
+		return due
+	}
+
+	var keep []*Timer
+	for _, t := range timers {
+		switch {
+		case t.cancelled.Load():
+			s.pending.Add(-1)
+		case t.rounds > 0:
+			t.rounds--
+			keep = append(keep, t)
+		default:
+			s.pending.Add(-1)
+			t.fired.Store(true)
+			due = append(due, t)
+		}
+	}
+	s.slots[s.cursor] = keep
+	return due
+}
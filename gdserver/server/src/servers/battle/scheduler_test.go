@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "proto"
+)
+
+func TestSchedulerFiresInOrderAndNeverEarly(t *testing.T) {
+	// 槽数很少，较长的定时器需要转多圈
+	s := NewScheduler(5*time.Millisecond, 8)
+	defer s.Stop()
+
+	start := time.Now()
+	delays := []time.Duration{120 * time.Millisecond, 10 * time.Millisecond, 60 * time.Millisecond, 35 * time.Millisecond}
+
+	var mu sync.Mutex
+	var fired []time.Duration
+	var wg sync.WaitGroup
+	for _, d := range delays {
+		wg.Add(1)
+		at := start.Add(d)
+		s.Schedule(at, func() {
+			defer wg.Done()
+			if now := time.Now(); now.Before(at) {
+				t.Errorf("timer %v fired %v early", d, at.Sub(now))
+			}
+			mu.Lock()
+			fired = append(fired, d)
+			mu.Unlock()
+		})
+	}
+
+	cancelled := s.Schedule(start.Add(20*time.Millisecond), func() {
+		t.Error("cancelled timer fired")
+	})
+	cancelled.Cancel()
+
+	wg.Wait()
+	want := []time.Duration{10 * time.Millisecond, 35 * time.Millisecond, 60 * time.Millisecond, 120 * time.Millisecond}
+	if fmt.Sprint(fired) != fmt.Sprint(want) {
+		t.Fatalf("fire order = %v, want %v", fired, want)
+	}
+
+	// 已取消的定时器在转过所在槽后被清理
+	time.Sleep(80 * time.Millisecond)
+	if n := s.Pending(); n != 0 {
+		t.Fatalf("pending = %d, want 0", n)
+	}
+	if cancelled.Fired() {
+		t.Fatal("cancelled timer marked as fired")
+	}
+}
+
+func TestRoomGameUpdateDrivenByNextUpdate(t *testing.T) {
+	game := &tickGame{interval: 20 * time.Millisecond}
+	room := NewBattleRoom("tick-room", nil, GameType_WordCardGame)
+	room.Game = game
+	room.State = pb.RoomStatus_ROOM_STATUS_PLAYING
+	room.Run()
+	defer room.Stop(context.Background())
+
+	time.Sleep(300 * time.Millisecond)
+	// 约 15 次，调度有延迟时会少一些，但不能完全不更新
+	if n := game.updates.Load(); n < 5 || n > 16 {
+		t.Fatalf("game updated %d times in 300ms with 20ms interval", n)
+	}
+}
+
+// tickGame 按固定间隔请求更新的游戏，模拟帧同步或回合超时的游戏
+type tickGame struct {
+	Game
+	interval time.Duration
+	last     time.Time
+	updates  atomic.Int64
+}
+
+func (g *tickGame) Update() bool {
+	now := time.Now()
+	if g.last.IsZero() || now.Sub(g.last) >= g.interval {
+		g.last = now
+		g.updates.Add(1)
+	}
+	return true
+}
+
+func (g *tickGame) NextUpdate() time.Time {
+	if g.last.IsZero() {
+		return time.Now()
+	}
+	return g.last.Add(g.interval)
+}
+
+func (g *tickGame) EndGame() {}
+
+// ====================== 基准测试 ====================== //
+
+// 对比每个房间持有 Ticker（改造前）和共享时间轮（改造后）在 10k 房间下的 CPU 占用：
+//
+//	go test -run x -bench Rooms -benchtime 20x
+//
+// cpu-ms/s 为每秒墙钟时间消耗的 CPU 毫秒数
+
+const benchRoomCount = 10000
+
+// legacyRoom 模拟改造前的房间循环：100ms 游戏 Ticker、5 秒快照 Ticker 和 5 秒空闲检查 Ticker
+func legacyRoom(ctx context.Context, wg *sync.WaitGroup, cmdChan chan RoomCommand, game Game) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	snapshotTicker := time.NewTicker(RoomSnapshotInterval)
+	defer snapshotTicker.Stop()
+	gcTicker := time.NewTicker(5 * time.Second)
+	defer gcTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-cmdChan:
+		case <-ticker.C:
+			if game != nil {
+				game.Update()
+			}
+		case <-snapshotTicker.C:
+		case <-gcTicker.C:
+		}
+	}
+}
+
+func benchmarkLegacyRooms(b *testing.B, active bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for i := 0; i < benchRoomCount; i++ {
+		var game Game
+		if active {
+			game = &tickGame{interval: 100 * time.Millisecond}
+		}
+		wg.Add(1)
+		go legacyRoom(ctx, &wg, make(chan RoomCommand, 100), game)
+	}
+
+	measureRoomCPU(b)
+
+	cancel()
+	wg.Wait()
+}
+
+func benchmarkScheduledRooms(b *testing.B, active bool) {
+	// 基准测试期间不回收空房间
+	emptyTimeout := EmptyRoomTimeout
+	EmptyRoomTimeout = time.Hour
+	defer func() { EmptyRoomTimeout = emptyTimeout }()
+
+	rooms := make([]*BattleRoom, 0, benchRoomCount)
+	for i := 0; i < benchRoomCount; i++ {
+		room := NewBattleRoom(fmt.Sprintf("bench-%d", i), nil, GameType_WordCardGame)
+		if active {
+			room.Game = &tickGame{interval: 100 * time.Millisecond}
+			room.State = pb.RoomStatus_ROOM_STATUS_PLAYING
+		}
+		room.Run()
+		rooms = append(rooms, room)
+	}
+
+	measureRoomCPU(b)
+
+	for _, room := range rooms {
+		room.cancel()
+	}
+	for _, room := range rooms {
+		<-room.done
+	}
+}
+
+func BenchmarkRoomsIdleTicker(b *testing.B)      { benchmarkLegacyRooms(b, false) }
+func BenchmarkRoomsIdleScheduler(b *testing.B)   { benchmarkScheduledRooms(b, false) }
+func BenchmarkRoomsActiveTicker(b *testing.B)    { benchmarkLegacyRooms(b, true) }
+func BenchmarkRoomsActiveScheduler(b *testing.B) { benchmarkScheduledRooms(b, true) }
+
+// measureRoomCPU 每次迭代等待 100ms（一个游戏帧），统计期间进程消耗的 CPU
+func measureRoomCPU(b *testing.B) {
+	// 等房间循环全部启动
+	time.Sleep(200 * time.Millisecond)
+
+	b.ResetTimer()
+	startCPU := processCPU()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	elapsed := time.Since(start)
+	cpu := processCPU() - startCPU
+	b.StopTimer()
+
+	b.ReportMetric(float64(cpu.Milliseconds())/elapsed.Seconds(), "cpu-ms/s")
+	b.ReportMetric(float64(runtime.NumGoroutine()), "goroutines")
+}
+
+// processCPU 进程累计消耗的 CPU 时间（不含空闲），使用 runtime/metrics 以便在各平台运行
+func processCPU() time.Duration {
+	// CPU 统计在 GC 时更新
+	runtime.GC()
+	samples := []metrics.Sample{
+		{Name: "/cpu/classes/total:cpu-seconds"},
+		{Name: "/cpu/classes/idle:cpu-seconds"},
+	}
+	metrics.Read(samples)
+	seconds := samples[0].Value.Float64() - samples[1].Value.Float64()
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"common/rpc"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+)
+
+// loadBattleGRPCPort 战斗服gRPC端口，可通过 BATTLE_GRPC_PORT 配置，便于本地同时运行多个实例
+func loadBattleGRPCPort() int {
+	if v := os.Getenv("BATTLE_GRPC_PORT"); v != "" {
+		if port, err := strconv.Atoi(v); err == nil && port > 0 {
+			return port
+		}
+		slog.Warn("Invalid BATTLE_GRPC_PORT, using default", "value", v, "default", rpc.RoomServiceGRPCPort)
+	}
+	return rpc.RoomServiceGRPCPort
+}
+
+// loadAdvertiseAddress 对外公布的gRPC地址（写入服务发现和房间归属），可通过 BATTLE_ADVERTISE_HOST 指定主机
+func loadAdvertiseAddress(port int) string {
+	host := os.Getenv("BATTLE_ADVERTISE_HOST")
+	if host == "" {
+		hostIP, err := getLocalIP()
+		if err != nil {
+			slog.Error("Failed to get local IP", "error", err)
+			hostIP = "127.0.0.1"
+		}
+		host = hostIP
+	}
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+// claimRoom 将房间归属记录为本实例，GameServer 和 MatchServer 据此路由房间请求
+// 归属记录和房间快照同时过期，每次写入快照时续期
+func (s *BattleServer) claimRoom(roomID string) {
+	if s == nil || s.Router == nil {
+		return
+	}
+	if err := s.Router.SetRoomOwner(roomID, s.GRPCAddr, roomSnapshotTTL); err != nil {
+		slog.Error("Failed to set room owner", "room_id", roomID, "address", s.GRPCAddr, "error", err)
+	}
+	// 调用方可能持有 RoomsMutex，异步上报负载
+	go s.reportLoad()
+}
+
+// refreshRoomOwner 写入快照时延长归属记录的过期时间
+func (s *BattleServer) refreshRoomOwner(roomID string) {
+	if s == nil || s.Router == nil {
+		return
+	}
+	if err := s.Router.RefreshRoomOwner(roomID, roomSnapshotTTL); err != nil {
+		slog.Warn("Failed to refresh room owner", "room_id", roomID, "error", err)
+	}
+}
+
+// releaseRoom 房间关闭后删除归属记录
+func (s *BattleServer) releaseRoom(roomID string) {
+	if s == nil || s.Router == nil {
+		return
+	}
+	if err := s.Router.DeleteRoomOwner(roomID); err != nil {
+		slog.Warn("Failed to delete room owner", "room_id", roomID, "error", err)
+	}
+	go s.reportLoad()
+}
+
+// reportLoad 上报本实例当前的房间数，创建房间时按负载选择实例
+func (s *BattleServer) reportLoad() {
+	// 维护模式下已从负载表移除，不再上报
+	if s.Router == nil || s.InstanceID == "" || s.IsDraining() {
+		return
+	}
+	s.RoomsMutex.RLock()
+	roomCount := len(s.BattleRooms)
+	s.RoomsMutex.RUnlock()
+
+	if err := s.Router.ReportLoad(s.InstanceID, roomCount); err != nil {
+		slog.Warn("Failed to report battle load", "instance", s.InstanceID, "error", err)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"common/authsign"
+	"fmt"
+	"os"
+	pb "proto"
+	"strconv"
+	"time"
+)
+
+// 认证请求签名校验方式
+const (
+	AuthSignatureOff      = "off"      // 不校验（未配置密钥时的默认值）
+	AuthSignatureOptional = "optional" // 只校验带签名的请求，用于客户端逐步接入
+	AuthSignatureRequired = "required" // 必须签名（配置了密钥时的默认值）
+)
+
+// maxNonceLength 随机数最大长度
+const maxNonceLength = 64
+
+// AuthVerifier 校验 AuthRequest 的 HMAC 签名、时间戳和随机数
+// 签名参数见 authSignParams，签名方式见 common/authsign；
+// 随机数在 Redis 中保留 2*MaxSkew，时间戳超出 MaxSkew 的请求直接拒绝，因此同一请求无法重放
+type AuthVerifier struct {
+	Mode    string
+	Secrets map[string]string // app_id -> 密钥
+	MaxSkew time.Duration     // 客户端与服务器允许的时间差
+	now     func() time.Time
+	// useNonce 记录随机数，已存在时返回 false
+	useNonce func(key string, ttl time.Duration) (bool, error)
+}
+
+// GlobalAuthVerifier 在 main 中根据环境变量初始化
+var GlobalAuthVerifier = &AuthVerifier{Mode: AuthSignatureOff}
+
+// LoadAuthVerifierFromEnv 读取签名配置
+//   - GAME_AUTH_APP_SECRETS：应用密钥，格式 "app_id:secret,app_id:secret"
+//   - GAME_AUTH_SIGNATURE：off / optional / required，默认配置了密钥时为 required，否则为 off
+//   - GAME_AUTH_MAX_SKEW：允许的时间差，默认 5m
+func LoadAuthVerifierFromEnv() (*AuthVerifier, error) {
+	secrets, err := authsign.LoadSecretsFromEnv("GAME_AUTH_APP_SECRETS")
+	if err != nil {
+		return nil, err
+	}
+
+	mode := os.Getenv("GAME_AUTH_SIGNATURE")
+	if mode == "" {
+		mode = AuthSignatureOff
+		if len(secrets) > 0 {
+			mode = AuthSignatureRequired
+		}
+	}
+	switch mode {
+	case AuthSignatureOff:
+	case AuthSignatureOptional, AuthSignatureRequired:
+		if len(secrets) == 0 {
+			return nil, fmt.Errorf("GAME_AUTH_SIGNATURE=%s requires GAME_AUTH_APP_SECRETS", mode)
+		}
+	default:
+		return nil, fmt.Errorf("invalid GAME_AUTH_SIGNATURE %q", mode)
+	}
+
+	return &AuthVerifier{
+		Mode:    mode,
+		Secrets: secrets,
+		MaxSkew: loadDurationFromEnv("GAME_AUTH_MAX_SKEW", 5*time.Minute),
+		now:     time.Now,
+		useNonce: func(key string, ttl time.Duration) (bool, error) {
+			return GlobalRedis.SetNXEx(key, "1", ttl)
+		},
+	}, nil
+}
+
+// Verify 校验认证请求，失败时返回错误码和原因
+func (v *AuthVerifier) Verify(req *pb.AuthRequest) (pb.ErrorCode, string) {
+	if v.Mode == AuthSignatureOff {
+		return pb.ErrorCode_OK, ""
+	}
+	if req.GetSignature() == "" {
+		if v.Mode == AuthSignatureOptional {
+			return pb.ErrorCode_OK, ""
+		}
+		return pb.ErrorCode_INVALID_SIGNATURE, "signature required"
+	}
+
+	secret, ok := v.Secrets[req.GetAppId()]
+	if !ok {
+		return pb.ErrorCode_UNKNOWN_APP, "unknown app_id"
+	}
+	if !authsign.Verify(secret, authSignParams(req), req.GetSignature()) {
+		return pb.ErrorCode_INVALID_SIGNATURE, "signature mismatch"
+	}
+
+	skew := v.now().Sub(time.UnixMilli(req.GetTimestamp()))
+	if skew > v.MaxSkew || skew < -v.MaxSkew {
+		return pb.ErrorCode_TIMESTAMP_EXPIRED, fmt.Sprintf("timestamp skew %s exceeds %s", skew.Round(time.Second), v.MaxSkew)
+	}
+
+	if req.GetNonce() == "" || len(req.GetNonce()) > maxNonceLength {
+		return pb.ErrorCode_INVALID_PARAM, "invalid nonce"
+	}
+	fresh, err := v.useNonce(fmt.Sprintf("auth_nonce:%s:%s", req.GetAppId(), req.GetNonce()), 2*v.MaxSkew)
+	if err != nil {
+		return pb.ErrorCode_SERVER_ERROR, "nonce check failed: " + err.Error()
+	}
+	if !fresh {
+		return pb.ErrorCode_NONCE_REUSED, "nonce already used"
+	}
+	return pb.ErrorCode_OK, ""
+}
+
+// authSignParams 参与签名的字段（timestamp 为 Unix 毫秒，is_guest 为 true/false）
+func authSignParams(req *pb.AuthRequest) map[string]string {
+	return map[string]string{
+		"token":            req.GetToken(),
+		"protocol_version": req.GetProtocolVersion(),
+		"client_version":   req.GetClientVersion(),
+		"device_type":      req.GetDeviceType(),
+		"device_id":        req.GetDeviceId(),
+		"app_id":           req.GetAppId(),
+		"nonce":            req.GetNonce(),
+		"timestamp":        strconv.FormatInt(req.GetTimestamp(), 10),
+		"is_guest":         strconv.FormatBool(req.GetIsGuest()),
+	}
+}
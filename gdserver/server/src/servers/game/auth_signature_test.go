@@ -0,0 +1,109 @@
+package main
+
+import (
+	"common/authsign"
+	"errors"
+	pb "proto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestVerifier 固定当前时间，随机数记录在内存中
+func newTestVerifier(mode string, now time.Time) *AuthVerifier {
+	used := make(map[string]bool)
+	return &AuthVerifier{
+		Mode:    mode,
+		Secrets: map[string]string{"app1": "secret1"},
+		MaxSkew: 5 * time.Minute,
+		now:     func() time.Time { return now },
+		useNonce: func(key string, _ time.Duration) (bool, error) {
+			if used[key] {
+				return false, nil
+			}
+			used[key] = true
+			return true, nil
+		},
+	}
+}
+
+// signedAuthRequest 按 secret 签名的认证请求，modify 在签名前修改请求
+func signedAuthRequest(secret string, ts time.Time, modify func(*pb.AuthRequest)) *pb.AuthRequest {
+	req := &pb.AuthRequest{
+		Token:     "token",
+		DeviceId:  "device",
+		AppId:     "app1",
+		Nonce:     "n-1",
+		Timestamp: ts.UnixMilli(),
+	}
+	if modify != nil {
+		modify(req)
+	}
+	req.Signature = authsign.Sign(secret, authSignParams(req))
+	return req
+}
+
+func TestAuthVerifierVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		name string
+		mode string
+		req  *pb.AuthRequest
+		want pb.ErrorCode
+	}{
+		{"valid", AuthSignatureRequired, signedAuthRequest("secret1", now, nil), pb.ErrorCode_OK},
+		{"off ignores signature", AuthSignatureOff, &pb.AuthRequest{Signature: "bogus"}, pb.ErrorCode_OK},
+		{"optional without signature", AuthSignatureOptional, &pb.AuthRequest{}, pb.ErrorCode_OK},
+		{"optional checks present signature", AuthSignatureOptional, signedAuthRequest("wrong", now, nil), pb.ErrorCode_INVALID_SIGNATURE},
+		{"required without signature", AuthSignatureRequired, &pb.AuthRequest{AppId: "app1"}, pb.ErrorCode_INVALID_SIGNATURE},
+		{"unknown app", AuthSignatureRequired,
+			signedAuthRequest("secret1", now, func(r *pb.AuthRequest) { r.AppId = "app2" }), pb.ErrorCode_UNKNOWN_APP},
+		{"wrong secret", AuthSignatureRequired, signedAuthRequest("secret2", now, nil), pb.ErrorCode_INVALID_SIGNATURE},
+		{"tampered field", AuthSignatureRequired, func() *pb.AuthRequest {
+			req := signedAuthRequest("secret1", now, nil)
+			req.Token = "other"
+			return req
+		}(), pb.ErrorCode_INVALID_SIGNATURE},
+		{"skew within limit", AuthSignatureRequired, signedAuthRequest("secret1", now.Add(-4*time.Minute), nil), pb.ErrorCode_OK},
+		{"too old", AuthSignatureRequired, signedAuthRequest("secret1", now.Add(-6*time.Minute), nil), pb.ErrorCode_TIMESTAMP_EXPIRED},
+		{"too far ahead", AuthSignatureRequired, signedAuthRequest("secret1", now.Add(6*time.Minute), nil), pb.ErrorCode_TIMESTAMP_EXPIRED},
+		{"empty nonce", AuthSignatureRequired,
+			signedAuthRequest("secret1", now, func(r *pb.AuthRequest) { r.Nonce = "" }), pb.ErrorCode_INVALID_PARAM},
+		{"nonce too long", AuthSignatureRequired, signedAuthRequest("secret1", now, func(r *pb.AuthRequest) {
+			r.Nonce = strings.Repeat("n", maxNonceLength+1)
+		}), pb.ErrorCode_INVALID_PARAM},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newTestVerifier(tt.mode, now)
+			if got, reason := v.Verify(tt.req); got != tt.want {
+				t.Fatalf("Verify = %v (%s), want %v", got, reason, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthVerifierNonceReuse(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	v := newTestVerifier(AuthSignatureRequired, now)
+
+	req := signedAuthRequest("secret1", now, nil)
+	if got, _ := v.Verify(req); got != pb.ErrorCode_OK {
+		t.Fatalf("first use = %v", got)
+	}
+	if got, _ := v.Verify(req); got != pb.ErrorCode_NONCE_REUSED {
+		t.Fatalf("replay = %v, want NONCE_REUSED", got)
+	}
+	// 随机数按应用区分
+	v.Secrets["app2"] = "secret2"
+	other := signedAuthRequest("secret2", now, func(r *pb.AuthRequest) { r.AppId = "app2" })
+	if got, _ := v.Verify(other); got != pb.ErrorCode_OK {
+		t.Fatalf("same nonce for another app = %v", got)
+	}
+
+	v.useNonce = func(string, time.Duration) (bool, error) { return false, errors.New("redis down") }
+	fresh := signedAuthRequest("secret1", now, func(r *pb.AuthRequest) { r.Nonce = "n-2" })
+	if got, _ := v.Verify(fresh); got != pb.ErrorCode_SERVER_ERROR {
+		t.Fatalf("nonce store failure = %v, want SERVER_ERROR", got)
+	}
+}
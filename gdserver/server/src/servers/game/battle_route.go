@@ -0,0 +1,88 @@
+package main
+
+import (
+	"common/discovery"
+	"common/rpc"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	pb "proto"
+	"time"
+)
+
+// GlobalBattleRouter 战斗服路由（创建房间按负载选实例，房间请求按归属路由）
+var GlobalBattleRouter *discovery.BattleRouter
+
+// defaultBattleServerAddr 服务发现中没有战斗服实例时使用的默认地址（本地单实例开发）
+var defaultBattleServerAddr = fmt.Sprintf("127.0.0.1:%d", rpc.RoomServiceGRPCPort)
+
+// battleServerAddr 获取处理该房间的战斗服地址，roomID 为空表示创建新房间，按负载选择实例
+func battleServerAddr(roomID string) (string, error) {
+	if GlobalBattleRouter == nil {
+		return defaultBattleServerAddr, nil
+	}
+
+	if roomID != "" {
+		addr, err := GlobalBattleRouter.RoomOwner(roomID)
+		if err != nil {
+			return "", fmt.Errorf("查询房间归属失败 room=%s: %w", roomID, err)
+		}
+		return addr, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	instance, err := GlobalBattleRouter.PickInstance(ctx)
+	if err != nil {
+		if errors.Is(err, discovery.ErrNoBattleInstance) {
+			slog.Warn("No battle server discovered, using default address", "address", defaultBattleServerAddr)
+			return defaultBattleServerAddr, nil
+		}
+		return "", fmt.Errorf("选择战斗服实例失败: %w", err)
+	}
+	return instance.Address, nil
+}
+
+// battleServerAddrs 获取所有战斗服地址，用于需要跨实例汇总的请求（房间列表、重连同步）
+func battleServerAddrs() []string {
+	if GlobalBattleRouter == nil {
+		return []string{defaultBattleServerAddr}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	instances, err := GlobalBattleRouter.Instances(ctx)
+	if err != nil || len(instances) == 0 {
+		if err != nil {
+			slog.Error("Failed to discover battle servers", "error", err)
+		}
+		return []string{defaultBattleServerAddr}
+	}
+
+	addrs := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		addrs = append(addrs, instance.Address)
+	}
+	return addrs
+}
+
+// battleClientAt 指定地址战斗服的共享客户端
+func battleClientAt(addr string) (pb.RoomRpcServiceClient, error) {
+	conn, err := rpcClients.Conn(addr)
+	if err != nil {
+		return nil, fmt.Errorf("连接BattleServer失败 %s: %w", addr, err)
+	}
+	return pb.NewRoomRpcServiceClient(conn), nil
+}
+
+// battleClient 处理该房间的战斗服客户端，roomID 为空表示按负载选择实例
+func battleClient(roomID string) (pb.RoomRpcServiceClient, error) {
+	addr, err := battleServerAddr(roomID)
+	if err != nil {
+		return nil, err
+	}
+	return battleClientAt(addr)
+}
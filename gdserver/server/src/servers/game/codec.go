@@ -0,0 +1,31 @@
+package main
+
+import (
+	pb "proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec 客户端消息（pb.Message）的编解码
+type Codec interface {
+	Name() string
+	// Binary 编码结果是否为二进制，WebSocket 据此选择二进制帧（带长度头）或文本帧
+	Binary() bool
+	Marshal(msg *pb.Message) ([]byte, error)
+	Unmarshal(data []byte, msg *pb.Message) error
+}
+
+// ProtoCodec protobuf 二进制编码（默认）
+type ProtoCodec struct{}
+
+func (ProtoCodec) Name() string { return "proto" }
+
+func (ProtoCodec) Binary() bool { return true }
+
+func (ProtoCodec) Marshal(msg *pb.Message) ([]byte, error) {
+	return proto.Marshal(msg)
+}
+
+func (ProtoCodec) Unmarshal(data []byte, msg *pb.Message) error {
+	return proto.Unmarshal(data, msg)
+}
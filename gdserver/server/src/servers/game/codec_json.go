@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	pb "proto"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// JSONCodec protojson 文本编码，供不方便使用 protobuf 的 WebSocket 客户端（网页调试、脚本）使用
+// 外层 pb.Message 和 data 中的消息体都编码为 JSON，例如：
+//
+//	{"clientId":"", "msgSerialNo":1, "id":"JOIN_ROOM_REQUEST", "data":{"roomId":"1001"}}
+//
+// data 的消息类型由 id 决定（见 payloadTypes），服务器内部仍按 protobuf 字节处理，处理器不感知编码方式。
+// 未登记类型的消息 data 保持 protojson 对 bytes 的默认编码（base64 字符串）。
+type JSONCodec struct{}
+
+var (
+	jsonMarshalOptions   = protojson.MarshalOptions{EmitUnpopulated: true}
+	jsonUnmarshalOptions = protojson.UnmarshalOptions{}
+)
+
+// payloadTypes 消息ID对应的消息体类型
+// 新增消息ID时需要在这里登记，否则 JSON 客户端收到的 data 是 base64 字符串
+var payloadTypes = map[pb.MessageId]func() proto.Message{
+	pb.MessageId_AUTH_REQUEST:                    func() proto.Message { return &pb.AuthRequest{} },
+	pb.MessageId_AUTH_RESPONSE:                   func() proto.Message { return &pb.AuthResponse{} },
+	pb.MessageId_GET_USER_INFO_REQUEST:           func() proto.Message { return &pb.GetUserInfoRequest{} },
+	pb.MessageId_GET_USER_INFO_RESPONSE:          func() proto.Message { return &pb.GetUserInfoResponse{} },
+	pb.MessageId_GET_ROOM_LIST_REQUEST:           func() proto.Message { return &pb.GetRoomListRequest{} },
+	pb.MessageId_GET_ROOM_LIST_RESPONSE:          func() proto.Message { return &pb.GetRoomListResponse{} },
+	pb.MessageId_CREATE_ROOM_REQUEST:             func() proto.Message { return &pb.CreateRoomRequest{} },
+	pb.MessageId_CREATE_ROOM_RESPONSE:            func() proto.Message { return &pb.CreateRoomResponse{} },
+	pb.MessageId_JOIN_ROOM_REQUEST:               func() proto.Message { return &pb.JoinRoomRequest{} },
+	pb.MessageId_JOIN_ROOM_RESPONSE:              func() proto.Message { return &pb.JoinRoomResponse{} },
+	pb.MessageId_LEAVE_ROOM_REQUEST:              func() proto.Message { return &pb.LeaveRoomRequest{} },
+	pb.MessageId_LEAVE_ROOM_RESPONSE:             func() proto.Message { return &pb.LeaveRoomResponse{} },
+	pb.MessageId_ROOM_STATE_NOTIFICATION:         func() proto.Message { return &pb.RoomDetail{} },
+	pb.MessageId_GAME_STATE_NOTIFICATION:         func() proto.Message { return &pb.GameStateNotify{} },
+	pb.MessageId_DRAW_CARD_REQUEST:               func() proto.Message { return &pb.DrawCardRequest{} },
+	pb.MessageId_DRAW_CARD_RESPONSE:              func() proto.Message { return &pb.DrawCardResponse{} },
+	pb.MessageId_GET_READY_REQUEST:               func() proto.Message { return &pb.GetReadyRequest{} },
+	pb.MessageId_GET_READY_RESPONSE:              func() proto.Message { return &pb.GetReadyResponse{} },
+	pb.MessageId_GAME_ACTION_REQUEST:             func() proto.Message { return &pb.GameActionRequest{} },
+	pb.MessageId_GAME_ACTION_RESPONSE:            func() proto.Message { return &pb.GameActionResponse{} },
+	pb.MessageId_GAME_ACTION_NOTIFICATION:        func() proto.Message { return &pb.PlayerActionNotify{} },
+	pb.MessageId_GAME_START_NOTIFICATION:         func() proto.Message { return &pb.GameStartNotification{} },
+	pb.MessageId_GAME_END_NOTIFICATION:           func() proto.Message { return &pb.GameEndNotification{} },
+	pb.MessageId_MATCH_REQUEST:                   func() proto.Message { return &pb.MatchRequest{} },
+	pb.MessageId_MATCH_RESPONSE:                  func() proto.Message { return &pb.MatchResponse{} },
+	pb.MessageId_MATCH_RESULT_NOTIFY:             func() proto.Message { return &pb.MatchResultNotify{} },
+	pb.MessageId_CANCEL_MATCH_REQUEST:            func() proto.Message { return &pb.CancelMatchRequest{} },
+	pb.MessageId_CANCEL_MATCH_RESPONSE:           func() proto.Message { return &pb.CancelMatchResponse{} },
+	pb.MessageId_JOIN_ROOM_BY_CODE_REQUEST:       func() proto.Message { return &pb.JoinRoomByCodeRequest{} },
+	pb.MessageId_JOIN_ROOM_BY_CODE_RESPONSE:      func() proto.Message { return &pb.JoinRoomResponse{} },
+	pb.MessageId_SEND_ROOM_INVITE_REQUEST:        func() proto.Message { return &pb.SendRoomInviteRequest{} },
+	pb.MessageId_SEND_ROOM_INVITE_RESPONSE:       func() proto.Message { return &pb.SendRoomInviteResponse{} },
+	pb.MessageId_ROOM_INVITE_NOTIFICATION:        func() proto.Message { return &pb.RoomInvite{} },
+	pb.MessageId_ROOM_CLOSED_NOTIFICATION:        func() proto.Message { return &pb.RoomClosedNotification{} },
+	pb.MessageId_KICKED_FROM_ROOM_NOTIFICATION:   func() proto.Message { return &pb.KickedFromRoomNotification{} },
+	pb.MessageId_SERVER_MAINTENANCE_NOTIFICATION: func() proto.Message { return &pb.ServerMaintenanceNotification{} },
+	pb.MessageId_SYSTEM_MESSAGE_NOTIFICATION:     func() proto.Message { return &pb.SystemMessageNotification{} },
+	pb.MessageId_HEARTBEAT_REQUEST:               func() proto.Message { return &pb.HeartbeatRequest{} },
+	pb.MessageId_HEARTBEAT_RESPONSE:              func() proto.Message { return &pb.HeartbeatResponse{} },
+	pb.MessageId_PUSH_ACK:                        func() proto.Message { return &pb.PushAck{} },
+
+	// 账号、会话相关
+	pb.MessageId_LOGGED_IN_ELSEWHERE_NOTIFICATION: func() proto.Message { return &pb.LoggedInElsewhereNotification{} },
+	pb.MessageId_SESSION_REVOKED_NOTIFICATION:     func() proto.Message { return &pb.SessionRevokedNotification{} },
+	pb.MessageId_LOGOUT_REQUEST:                   func() proto.Message { return &pb.LogoutRequest{} },
+	pb.MessageId_LOGOUT_RESPONSE:                  func() proto.Message { return &pb.LogoutResponse{} },
+}
+
+func (JSONCodec) Name() string { return "json" }
+
+func (JSONCodec) Binary() bool { return false }
+
+func (JSONCodec) Marshal(msg *pb.Message) ([]byte, error) {
+	newPayload, ok := payloadTypes[msg.GetId()]
+	if !ok {
+		// 未登记的类型按 protojson 默认方式输出 base64
+		return jsonMarshalOptions.Marshal(msg)
+	}
+
+	envelope := proto.Clone(msg).(*pb.Message)
+	envelope.Data = nil
+	data, err := jsonMarshalOptions.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := newPayload()
+	if err := proto.Unmarshal(msg.GetData(), payload); err != nil {
+		return nil, fmt.Errorf("decode %s payload: %w", msg.GetId(), err)
+	}
+	payloadJSON, err := jsonMarshalOptions.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["data"] = payloadJSON
+	return json.Marshal(fields)
+}
+
+func (JSONCodec) Unmarshal(data []byte, msg *pb.Message) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	payloadJSON, hasPayload := fields["data"]
+	delete(fields, "data")
+
+	envelope, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if err := jsonUnmarshalOptions.Unmarshal(envelope, msg); err != nil {
+		return err
+	}
+	if !hasPayload || string(payloadJSON) == "null" {
+		return nil
+	}
+
+	// data 为字符串时按 base64 处理（与 protojson 的 bytes 编码一致），兼容未登记类型的消息
+	var encoded string
+	if json.Unmarshal(payloadJSON, &encoded) == nil {
+		msg.Data, err = base64.StdEncoding.DecodeString(encoded)
+		return err
+	}
+
+	newPayload, ok := payloadTypes[msg.GetId()]
+	if !ok {
+		return fmt.Errorf("no JSON payload type for message %s", msg.GetId())
+	}
+	payload := newPayload()
+	if err := jsonUnmarshalOptions.Unmarshal(payloadJSON, payload); err != nil {
+		return fmt.Errorf("decode %s payload: %w", msg.GetId(), err)
+	}
+	msg.Data, err = proto.Marshal(payload)
+	return err
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	pb "proto"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fillScalars 为消息的标量字段填入非零值，嵌套消息、列表和 map 保持为空
+func fillScalars(m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if f.IsList() || f.IsMap() {
+			continue
+		}
+		var v protoreflect.Value
+		switch f.Kind() {
+		case protoreflect.BoolKind:
+			v = protoreflect.ValueOfBool(true)
+		case protoreflect.EnumKind:
+			v = protoreflect.ValueOfEnum(1)
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+			v = protoreflect.ValueOfInt32(int32(i + 1))
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			v = protoreflect.ValueOfInt64(int64(i + 1))
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			v = protoreflect.ValueOfUint32(uint32(i + 1))
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			v = protoreflect.ValueOfUint64(uint64(i + 1))
+		case protoreflect.FloatKind:
+			v = protoreflect.ValueOfFloat32(1.5)
+		case protoreflect.DoubleKind:
+			v = protoreflect.ValueOfFloat64(2.5)
+		case protoreflect.StringKind:
+			v = protoreflect.ValueOfString(string(f.Name()))
+		case protoreflect.BytesKind:
+			v = protoreflect.ValueOfBytes([]byte{0, 1, 2})
+		default:
+			continue
+		}
+		m.Set(f, v)
+	}
+}
+
+func TestJSONCodecRoundTripsEveryPayloadType(t *testing.T) {
+	codec := JSONCodec{}
+	for id, newPayload := range payloadTypes {
+		t.Run(id.String(), func(t *testing.T) {
+			payload := newPayload()
+			fillScalars(payload.ProtoReflect())
+			msg := &pb.Message{Id: id, MsgSerialNo: 3, ClientId: "c1", Data: mustMarshal(payload)}
+
+			data, err := codec.Marshal(msg)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(data, &fields); err != nil {
+				t.Fatalf("output is not JSON: %v", err)
+			}
+			if raw := fields["data"]; len(raw) == 0 || raw[0] != '{' {
+				t.Fatalf("data = %s, want a JSON object", raw)
+			}
+
+			var got pb.Message
+			if err := codec.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got.GetId() != id || got.GetMsgSerialNo() != 3 || got.GetClientId() != "c1" {
+				t.Fatalf("envelope = %v", &got)
+			}
+			gotPayload := newPayload()
+			if err := proto.Unmarshal(got.GetData(), gotPayload); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(gotPayload, payload) {
+				t.Fatalf("payload = %v, want %v", gotPayload, payload)
+			}
+		})
+	}
+}
+
+func TestJSONCodecUnmarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantData []byte
+		wantErr  bool
+	}{
+		{"payload object", `{"id":"JOIN_ROOM_REQUEST","msgSerialNo":1,"data":{"roomId":"1001"}}`,
+			mustMarshal(&pb.JoinRoomRequest{RoomId: "1001"}), false},
+		{"base64 payload", `{"id":"JOIN_ROOM_REQUEST","data":"CgQxMDAx"}`,
+			mustMarshal(&pb.JoinRoomRequest{RoomId: "1001"}), false},
+		{"no payload", `{"id":"HEARTBEAT_REQUEST"}`, nil, false},
+		{"null payload", `{"id":"HEARTBEAT_REQUEST","data":null}`, nil, false},
+		{"unknown payload field", `{"id":"JOIN_ROOM_REQUEST","data":{"nope":1}}`, nil, true},
+		{"object for unregistered id", `{"id":"LOGIN_REQUEST","data":{"a":1}}`, nil, true},
+		{"not json", `{"id":`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msg pb.Message
+			err := JSONCodec{}.Unmarshal([]byte(tt.input), &msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(msg.GetData()) != string(tt.wantData) {
+				t.Fatalf("data = %x, want %x", msg.GetData(), tt.wantData)
+			}
+		})
+	}
+}
@@ -2,16 +2,40 @@
 package main
 
 import (
+	"errors"
 	"net"
+	pb "proto"
 	"time"
 )
 
+// Connection 面向消息的客户端连接，同时支持 TCP 和 WebSocket
+// 每次 ReadMessage 返回一条完整的消息，分帧方式由具体连接决定：
+//   - TCP：4 字节小端长度头 + 消息体
+//   - WebSocket：一个二进制帧一条消息（帧内同样带 4 字节长度头，兼容现有客户端），长度头必须与帧长度一致；
+//     JSON 模式下一个文本帧一条消息，不带长度头
+//
+// 消息体的编解码由连接携带的 Codec 完成
 type Connection interface {
-	Read(b []byte) (n int, err error)
-	Write(b []byte) (n int, err error)
+	// ReadMessage 读取下一条消息，消息体无法解析时返回 ErrMalformedMessage（连接仍可继续读取）
+	ReadMessage() (*pb.Message, error)
+	// WriteMessage 发送一条消息，只能在一个协程中调用
+	WriteMessage(msg *pb.Message) error
+	Codec() Codec
 	Close() error
 	RemoteAddr() net.Addr
-	SetDeadline(t time.Time) error
 	SetReadDeadline(t time.Time) error
 	SetWriteDeadline(t time.Time) error
 }
+
+var (
+	// ErrFrameTooLarge 帧长度超过 MaxFrameSize（或长度为 0），连接需要关闭
+	ErrFrameTooLarge = errors.New("frame too large")
+	// ErrMalformedMessage 帧完整但消息无法解析，丢弃该帧后可以继续读取
+	ErrMalformedMessage = errors.New("malformed message")
+)
+
+// MaxFrameSize 单条消息的最大长度（字节，不含长度头），可通过 GAME_MAX_FRAME_SIZE 配置
+var MaxFrameSize = loadIntFromEnv("GAME_MAX_FRAME_SIZE", 1024*1024)
+
+// frameHeaderSize 长度头字节数
+const frameHeaderSize = 4
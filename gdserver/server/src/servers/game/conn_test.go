@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	pb "proto"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func frameOf(t *testing.T, serial int32) []byte {
+	t.Helper()
+	return appendFrameHeader(mustMarshal(&pb.Message{Id: pb.MessageId_HEARTBEAT_REQUEST, MsgSerialNo: serial}))
+}
+
+func withMaxFrameSize(t *testing.T, size int) {
+	t.Helper()
+	old := MaxFrameSize
+	MaxFrameSize = size
+	t.Cleanup(func() { MaxFrameSize = old })
+}
+
+// readResult 一次 ReadMessage 的期望结果：读到序号为 serial 的消息，或 err 为 wantErr
+type readResult struct {
+	serial  int32
+	wantErr error
+}
+
+func checkRead(t *testing.T, i int, msg *pb.Message, err error, want readResult) {
+	t.Helper()
+	if want.wantErr != nil {
+		if !errors.Is(err, want.wantErr) {
+			t.Fatalf("read %d: err = %v, want %v", i, err, want.wantErr)
+		}
+		return
+	}
+	if err != nil || msg.GetMsgSerialNo() != want.serial {
+		t.Fatalf("read %d: msg = %v, err = %v, want serial %d", i, msg, err, want.serial)
+	}
+}
+
+func TestTCPConnectionFraming(t *testing.T) {
+	withMaxFrameSize(t, 64)
+
+	tests := []struct {
+		name   string
+		writes func(t *testing.T) [][]byte
+		reads  []readResult
+	}{
+		{"one frame per write", func(t *testing.T) [][]byte {
+			return [][]byte{frameOf(t, 1), frameOf(t, 2)}
+		}, []readResult{{serial: 1}, {serial: 2}, {wantErr: io.EOF}}},
+		{"frames coalesced", func(t *testing.T) [][]byte {
+			return [][]byte{append(frameOf(t, 1), frameOf(t, 2)...)}
+		}, []readResult{{serial: 1}, {serial: 2}, {wantErr: io.EOF}}},
+		{"frame split byte by byte", func(t *testing.T) [][]byte {
+			var writes [][]byte
+			for _, b := range frameOf(t, 3) {
+				writes = append(writes, []byte{b})
+			}
+			return writes
+		}, []readResult{{serial: 3}, {wantErr: io.EOF}}},
+		{"malformed body keeps stream", func(t *testing.T) [][]byte {
+			return [][]byte{appendFrameHeader([]byte{0xff}), frameOf(t, 4)}
+		}, []readResult{{wantErr: ErrMalformedMessage}, {serial: 4}}},
+		{"zero length", func(t *testing.T) [][]byte {
+			return [][]byte{{0, 0, 0, 0}}
+		}, []readResult{{wantErr: ErrFrameTooLarge}}},
+		{"over max frame size", func(t *testing.T) [][]byte {
+			return [][]byte{appendFrameHeader(make([]byte, 65))}
+		}, []readResult{{wantErr: ErrFrameTooLarge}}},
+		{"truncated body", func(t *testing.T) [][]byte {
+			return [][]byte{frameOf(t, 5)[:6]}
+		}, []readResult{{wantErr: io.ErrUnexpectedEOF}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := net.Pipe()
+			defer server.Close()
+			writes := tt.writes(t)
+			go func() {
+				for _, w := range writes {
+					if _, err := client.Write(w); err != nil {
+						return
+					}
+				}
+				client.Close()
+			}()
+
+			conn := NewTCPConnection(server, ProtoCodec{})
+			for i, want := range tt.reads {
+				msg, err := conn.ReadMessage()
+				checkRead(t, i, msg, err, want)
+			}
+		})
+	}
+}
+
+// wsPair 建立 WebSocket 连接，返回服务端的 WSConnection 和客户端连接
+func wsPair(t *testing.T, codec Codec) (*WSConnection, *websocket.Conn) {
+	t.Helper()
+	conns := make(chan *WSConnection, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wsConn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		conns <- NewWSConnection(wsConn, codec)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := <-conns
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+	return server, client
+}
+
+func TestWSConnectionFraming(t *testing.T) {
+	withMaxFrameSize(t, 64)
+
+	type frame struct {
+		kind int
+		data []byte
+	}
+	msg1 := mustMarshal(&pb.Message{Id: pb.MessageId_HEARTBEAT_REQUEST, MsgSerialNo: 1})
+	tests := []struct {
+		name   string
+		codec  Codec
+		frames []frame
+		reads  []readResult
+	}{
+		{"binary frame", ProtoCodec{}, []frame{{websocket.BinaryMessage, appendFrameHeader(msg1)}},
+			[]readResult{{serial: 1}}},
+		{"length header mismatch", ProtoCodec{}, []frame{
+			{websocket.BinaryMessage, append(appendFrameHeader(msg1), 0)},
+			{websocket.BinaryMessage, appendFrameHeader(msg1)},
+		}, []readResult{{wantErr: ErrMalformedMessage}, {serial: 1}}},
+		{"frame shorter than header", ProtoCodec{}, []frame{{websocket.BinaryMessage, []byte{1, 0}}},
+			[]readResult{{wantErr: ErrMalformedMessage}}},
+		{"text frame with binary codec", ProtoCodec{}, []frame{{websocket.TextMessage, []byte("{}")}},
+			[]readResult{{wantErr: ErrMalformedMessage}}},
+		{"json text frame", JSONCodec{}, []frame{{websocket.TextMessage, []byte(`{"id":"HEARTBEAT_REQUEST","msgSerialNo":2}`)}},
+			[]readResult{{serial: 2}}},
+		{"binary frame with json codec", JSONCodec{}, []frame{{websocket.BinaryMessage, appendFrameHeader(msg1)}},
+			[]readResult{{wantErr: ErrMalformedMessage}}},
+		{"over read limit", ProtoCodec{}, []frame{{websocket.BinaryMessage, appendFrameHeader(make([]byte, 100))}},
+			[]readResult{{wantErr: ErrFrameTooLarge}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := wsPair(t, tt.codec)
+			for _, f := range tt.frames {
+				if err := client.WriteMessage(f.kind, f.data); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i, want := range tt.reads {
+				msg, err := server.ReadMessage()
+				checkRead(t, i, msg, err, want)
+			}
+		})
+	}
+}
+
+// TestWSConnectionWrite 二进制编码写带长度头的二进制帧，文本编码写文本帧
+func TestWSConnectionWrite(t *testing.T) {
+	for _, codec := range []Codec{ProtoCodec{}, JSONCodec{}} {
+		t.Run(codec.Name(), func(t *testing.T) {
+			server, client := wsPair(t, codec)
+			if err := server.WriteMessage(&pb.Message{Id: pb.MessageId_HEARTBEAT_RESPONSE, MsgSerialNo: 9}); err != nil {
+				t.Fatal(err)
+			}
+			kind, data, err := client.ReadMessage()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if codec.Binary() {
+				if kind != websocket.BinaryMessage {
+					t.Fatalf("frame type %d, want binary", kind)
+				}
+				data = data[frameHeaderSize:]
+			} else if kind != websocket.TextMessage {
+				t.Fatalf("frame type %d, want text", kind)
+			}
+			var msg pb.Message
+			if err := codec.Unmarshal(data, &msg); err != nil || msg.GetMsgSerialNo() != 9 {
+				t.Fatalf("decoded %v, err %v", &msg, err)
+			}
+		})
+	}
+}
@@ -40,8 +40,11 @@ type PlayerRepository interface {
 	GetPlayerContext(uid uint64) (PlayerDrawCardContext, error)
 }
 
+// CardConfigDir 卡牌配置目录，服务从 bin 目录启动，配置在 ../cfg
+var CardConfigDir = "../cfg/"
+
 func JsonLoader(filename string) ([]map[string]interface{}, error) {
-	file, err := os.Open("../cfg/" + filename + ".json")
+	file, err := os.Open(CardConfigDir + filename + ".json")
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +59,11 @@ func JsonLoader(filename string) ([]map[string]interface{}, error) {
 
 var CardSvc *CardService
 
-func init() {
+// InitCardService 加载卡牌配置并创建抽卡服务，启动时调用
+func InitCardService() error {
 	CardConfig, err := cfg.NewTables(JsonLoader)
 	if err != nil {
-		slog.Error("Failed to load card config", "error", err)
-		panic(err)
+		return err
 	}
 
 	// 使用 tables.TbDrawCard 获取配置数据
@@ -74,6 +77,7 @@ func init() {
 	//}
 
 	CardSvc = NewCardService(CardConfig, &DefaultPlayerRepo{}, []ProbabilityAdjuster{&VIPProbabilityAdjuster{}}, []CardGenerateInterceptor{&GuaranteeInterceptor{}})
+	return nil
 }
 
 type CardService struct {
@@ -119,6 +123,7 @@ func (s *CardService) DrawCards(uid uint64, count int32) *pb.DrawCardResponse {
 
 	// 记录抽卡次数等后置逻辑
 	s.afterDraw(ctx, resp.Cards)
+	observeGachaDraws(resp.Cards)
 	return resp
 }
 
@@ -348,11 +353,10 @@ var (
 	ErrRarityConfigNotFound = errors.New("rarity config not found")
 )
 
-func (p *Player) HandleDrawCardRequest(msg *pb.Message) {
+func (p *Player) HandleDrawCardRequest(msg *pb.Message) error {
 	var req pb.DrawCardRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse draw card Request", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid DrawCardRequest: %v", err)
 	}
 
 	// 2. 执行抽卡逻辑
@@ -361,5 +365,5 @@ func (p *Player) HandleDrawCardRequest(msg *pb.Message) {
 	slog.Info("Draw card request", "uid", req.Uid, "count", req.Count, "response", resp)
 
 	p.SendResponse(msg, mustMarshal(resp))
-
+	return nil
 }
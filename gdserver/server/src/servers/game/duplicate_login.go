@@ -0,0 +1,30 @@
+package main
+
+import (
+	"log/slog"
+	pb "proto"
+	"time"
+)
+
+// takeOver 同一账号在新连接登录，顶替旧连接
+// 旧连接收到 LOGGED_IN_ELSEWHERE_NOTIFICATION 后被关闭，退出时不离开房间、不取消匹配；
+// 房间状态随后由 resyncRoomState 推送给新连接，匹配结果按 uid 投递，自然发到新连接
+func (p *Player) takeOver(previous *Player, deviceType string) {
+	duplicateLogins.Inc()
+	// 旧连接的处理协程和通知协程可能仍在修改房间ID，只通过加锁的访问方法读取
+	roomID := previous.RoomID()
+	slog.Info("Duplicate login, kicking previous session", "uid", p.Uid,
+		"previous_conn_uuid", previous.ConnUUID, "conn_uuid", p.ConnUUID, "room_id", roomID)
+
+	previous.replaced.Store(true)
+	p.adoptRoomID(roomID)
+
+	previous.kick(&pb.Message{
+		Id:          pb.MessageId_LOGGED_IN_ELSEWHERE_NOTIFICATION,
+		MsgSerialNo: -1,
+		Data: mustMarshal(&pb.LoggedInElsewhereNotification{
+			LoginTime:  time.Now().UnixMilli(),
+			DeviceType: deviceType,
+		}),
+	})
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	pb "proto"
+	"testing"
+)
+
+// TestTakeOverClosesPreviousConnection 旧连接收到顶替通知后立即关闭，房间由新连接接管
+func TestTakeOverClosesPreviousConnection(t *testing.T) {
+	previous, client := runPipePlayer(t)
+	previous.setRoomID("1001")
+
+	p := newTestPlayer()
+	p.takeOver(previous, "pc")
+
+	expectClosedAfter(t, client, pb.MessageId_LOGGED_IN_ELSEWHERE_NOTIFICATION)
+	if !previous.replaced.Load() {
+		t.Fatal("previous connection not marked replaced")
+	}
+	if roomID := p.RoomID(); roomID != "1001" {
+		t.Fatalf("room ID = %q, want 1001", roomID)
+	}
+}
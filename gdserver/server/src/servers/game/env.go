@@ -0,0 +1,30 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+)
+
+// loadDurationFromEnv 从环境变量读取时长配置，未设置或格式错误时使用默认值
+func loadDurationFromEnv(name string, def time.Duration) time.Duration {
+	if v := os.Getenv(name); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+		slog.Warn("Invalid duration in env, using default", "env", name, "value", v, "default", def)
+	}
+	return def
+}
+
+// loadIntFromEnv 从环境变量读取正整数配置，未设置或格式错误时使用默认值
+func loadIntFromEnv(name string, def int) int {
+	if v := os.Getenv(name); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+		slog.Warn("Invalid integer in env, using default", "env", name, "value", v, "default", def)
+	}
+	return def
+}
@@ -2,14 +2,18 @@ package main
 
 import (
 	"common/rpc"
+	"common/tracing"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net"
 	"os"
 	pb "proto"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 type GameGRPCService struct {
@@ -24,7 +28,7 @@ func (s *GameGRPCService) StartGameGRPCService() {
 		os.Exit(1)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(tracing.ServerOption())
 	pb.RegisterGameRpcServiceServer(grpcServer, s)
 	slog.Info("game grpc service starting", "port", port)
 	if err := grpcServer.Serve(lis); err != nil {
@@ -42,6 +46,7 @@ func (s *GameGRPCService) RoomStatusNotifyRpc(ctx context.Context, req *pb.RoomD
 	player, ok := GlobalManager.GetPlayerByUin(req.BeNotifiedUid)
 	if !ok {
 		slog.Error("Player not found for notification", "player_id", req.BeNotifiedUid)
+		observeNotifyFailure("RoomStatusNotifyRpc", pb.ErrorCode_NOT_FOUND)
 		return &pb.NotifyResponse{
 			Ret: int32(pb.ErrorCode_NOT_FOUND),
 		}, nil
@@ -54,7 +59,13 @@ func (s *GameGRPCService) RoomStatusNotifyRpc(ctx context.Context, req *pb.RoomD
 		Data:        mustMarshal(req.Room),
 	}
 
-	player.SendMessage(noti)
+	if !player.Push(noti) {
+		slog.Warn("Player send queue full, notification dropped", "player_id", req.BeNotifiedUid, "msg_id", noti.Id)
+		observeNotifyFailure("RoomStatusNotifyRpc", pb.ErrorCode_SERVER_BUSY)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_SERVER_BUSY),
+		}, nil
+	}
 
 	slog.Info("RoomStatusNotifyRpc processed", "be_notified_uid", req.BeNotifiedUid)
 
@@ -72,6 +83,7 @@ func (s *GameGRPCService) GameStateNotifyRpc(ctx context.Context, req *pb.GameSt
 	player, ok := GlobalManager.GetPlayerByUin(req.BeNotifiedUid)
 	if !ok {
 		slog.Error("Player not found for notification", "player_id", req.BeNotifiedUid)
+		observeNotifyFailure("GameStateNotifyRpc", pb.ErrorCode_NOT_FOUND)
 		return &pb.NotifyResponse{
 			Ret: int32(pb.ErrorCode_NOT_FOUND),
 		}, nil
@@ -84,7 +96,13 @@ func (s *GameGRPCService) GameStateNotifyRpc(ctx context.Context, req *pb.GameSt
 		Data:        mustMarshal(req),
 	}
 
-	player.SendMessage(noti)
+	if !player.Push(noti) {
+		slog.Warn("Player send queue full, notification dropped", "player_id", req.BeNotifiedUid, "msg_id", noti.Id)
+		observeNotifyFailure("GameStateNotifyRpc", pb.ErrorCode_SERVER_BUSY)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_SERVER_BUSY),
+		}, nil
+	}
 
 	slog.Info("GameStateNotifyRpc processed", "be_notified_uid", req.BeNotifiedUid)
 
@@ -101,6 +119,7 @@ func (s *GameGRPCService) PlayerActionNotifyRpc(ctx context.Context, req *pb.Pla
 	player, ok := GlobalManager.GetPlayerByUin(req.BeNotifiedUid)
 	if !ok {
 		slog.Error("Player not found for notification", "player_id", req.BeNotifiedUid)
+		observeNotifyFailure("PlayerActionNotifyRpc", pb.ErrorCode_NOT_FOUND)
 		return &pb.NotifyResponse{
 			Ret: int32(pb.ErrorCode_NOT_FOUND),
 		}, nil
@@ -113,7 +132,13 @@ func (s *GameGRPCService) PlayerActionNotifyRpc(ctx context.Context, req *pb.Pla
 		Data:        mustMarshal(req),
 	}
 
-	player.SendMessage(noti)
+	if !player.Push(noti) {
+		slog.Warn("Player send queue full, notification dropped", "player_id", req.BeNotifiedUid, "msg_id", noti.Id)
+		observeNotifyFailure("PlayerActionNotifyRpc", pb.ErrorCode_SERVER_BUSY)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_SERVER_BUSY),
+		}, nil
+	}
 
 	slog.Info("PlayerActionNotifyRpc processed", "be_notified_uid", req.BeNotifiedUid)
 
@@ -130,6 +155,7 @@ func (s *GameGRPCService) GameStartNotifyRpc(ctx context.Context, req *pb.GameSt
 	player, ok := GlobalManager.GetPlayerByUin(req.BeNotifiedUid)
 	if !ok {
 		slog.Error("Player not found for notification", "player_id", req.BeNotifiedUid)
+		observeNotifyFailure("GameStartNotifyRpc", pb.ErrorCode_NOT_FOUND)
 		return &pb.NotifyResponse{
 			Ret: int32(pb.ErrorCode_NOT_FOUND),
 		}, nil
@@ -142,7 +168,13 @@ func (s *GameGRPCService) GameStartNotifyRpc(ctx context.Context, req *pb.GameSt
 		Data:        mustMarshal(req.GameStart),
 	}
 
-	player.SendMessage(noti)
+	if !player.Push(noti) {
+		slog.Warn("Player send queue full, notification dropped", "player_id", req.BeNotifiedUid, "msg_id", noti.Id)
+		observeNotifyFailure("GameStartNotifyRpc", pb.ErrorCode_SERVER_BUSY)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_SERVER_BUSY),
+		}, nil
+	}
 
 	slog.Info("GameStartNotifyRpc processed", "be_notified_uid", req.BeNotifiedUid)
 
@@ -159,6 +191,7 @@ func (s *GameGRPCService) GameEndNotifyRpc(ctx context.Context, req *pb.GameEndN
 	player, ok := GlobalManager.GetPlayerByUin(req.BeNotifiedUid)
 	if !ok {
 		slog.Error("Player not found for notification", "player_id", req.BeNotifiedUid)
+		observeNotifyFailure("GameEndNotifyRpc", pb.ErrorCode_NOT_FOUND)
 		return &pb.NotifyResponse{
 			Ret: int32(pb.ErrorCode_NOT_FOUND),
 		}, nil
@@ -171,7 +204,13 @@ func (s *GameGRPCService) GameEndNotifyRpc(ctx context.Context, req *pb.GameEndN
 		Data:        mustMarshal(req.GameEnd),
 	}
 
-	player.SendMessage(noti)
+	if !player.Push(noti) {
+		slog.Warn("Player send queue full, notification dropped", "player_id", req.BeNotifiedUid, "msg_id", noti.Id)
+		observeNotifyFailure("GameEndNotifyRpc", pb.ErrorCode_SERVER_BUSY)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_SERVER_BUSY),
+		}, nil
+	}
 
 	slog.Info("GameEndNotifyRpc processed", "be_notified_uid", req.BeNotifiedUid)
 
@@ -188,11 +227,17 @@ func (s *GameGRPCService) MatchResultNotifyRpc(ctx context.Context, req *pb.Matc
 	player, ok := GlobalManager.GetPlayerByUin(req.BeNotifiedUid)
 	if !ok {
 		slog.Error("Player not found for notification", "player_id", req.BeNotifiedUid)
+		observeNotifyFailure("MatchResultNotifyRpc", pb.ErrorCode_NOT_FOUND)
 		return &pb.NotifyResponse{
 			Ret: int32(pb.ErrorCode_NOT_FOUND),
 		}, nil
 	}
 
+	// 匹配成功后记录玩家所在房间，后续房间请求据此路由到对应的BattleServer
+	if roomID := req.MatchResult.GetRoom().GetRoom().GetId(); roomID != "" && req.MatchResult.Ret == int32(pb.ErrorCode_OK) {
+		player.setRoomID(roomID)
+	}
+
 	// 直接发送匹配结果通知
 	noti := &pb.Message{
 		Id:          pb.MessageId_MATCH_RESULT_NOTIFY,
@@ -201,7 +246,13 @@ func (s *GameGRPCService) MatchResultNotifyRpc(ctx context.Context, req *pb.Matc
 		Data:        mustMarshal(req.MatchResult),
 	}
 
-	player.SendMessage(noti)
+	if !player.Push(noti) {
+		slog.Warn("Player send queue full, notification dropped", "player_id", req.BeNotifiedUid, "msg_id", noti.Id)
+		observeNotifyFailure("MatchResultNotifyRpc", pb.ErrorCode_SERVER_BUSY)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_SERVER_BUSY),
+		}, nil
+	}
 
 	slog.Info("MatchResultNotifyRpc processed", "be_notified_uid", req.BeNotifiedUid, "ret", req.MatchResult.Ret)
 
@@ -209,3 +260,125 @@ func (s *GameGRPCService) MatchResultNotifyRpc(ctx context.Context, req *pb.Matc
 		Ret: int32(pb.ErrorCode_OK),
 	}, nil
 }
+
+func (s *GameGRPCService) RoomInviteNotifyRpc(ctx context.Context, req *pb.RoomInviteNotify) (*pb.NotifyResponse, error) {
+	// 处理房间邀请通知逻辑
+	slog.Info("Received RoomInviteNotifyRpc", "player_id", req.BeNotifiedUid, "room_id", req.Invite.GetRoom().GetId())
+
+	//通过 被通知者id 找到玩家的连接
+	player, ok := GlobalManager.GetPlayerByUin(req.BeNotifiedUid)
+	if !ok {
+		slog.Info("Invitee not online", "player_id", req.BeNotifiedUid)
+		observeNotifyFailure("RoomInviteNotifyRpc", pb.ErrorCode_NOT_FOUND)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_NOT_FOUND),
+		}, nil
+	}
+
+	noti := &pb.Message{
+		Id:          pb.MessageId_ROOM_INVITE_NOTIFICATION,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data:        mustMarshal(req.Invite),
+	}
+
+	if !player.Push(noti) {
+		slog.Warn("Player send queue full, notification dropped", "player_id", req.BeNotifiedUid, "msg_id", noti.Id)
+		observeNotifyFailure("RoomInviteNotifyRpc", pb.ErrorCode_SERVER_BUSY)
+		return &pb.NotifyResponse{
+			Ret: int32(pb.ErrorCode_SERVER_BUSY),
+		}, nil
+	}
+
+	slog.Info("RoomInviteNotifyRpc processed", "be_notified_uid", req.BeNotifiedUid)
+
+	return &pb.NotifyResponse{
+		Ret: int32(pb.ErrorCode_OK),
+	}, nil
+}
+
+// NotifyStream 接收战斗服的批量通知并投递给玩家，投递失败的通知通过流异步回报
+// 同一条流上的通知按顺序投递，保证同一玩家收到的通知有序；投递不阻塞，慢玩家不影响其他玩家
+func (s *GameGRPCService) NotifyStream(stream pb.GameRpcService_NotifyStreamServer) error {
+	slog.Info("Notify stream opened")
+
+	for {
+		batch, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				slog.Info("Notify stream closed")
+				return nil
+			}
+			slog.Warn("Notify stream receive failed", "error", err)
+			return err
+		}
+
+		var failures []*pb.NotificationFailure
+		for _, notification := range batch.Notifications {
+			if ret := deliverNotification(notification); ret != pb.ErrorCode_OK {
+				observeNotifyFailure("NotifyStream", ret)
+				failures = append(failures, &pb.NotificationFailure{
+					Seq:           notification.Seq,
+					BeNotifiedUid: notification.BeNotifiedUid,
+					Ret:           ret,
+				})
+			}
+		}
+
+		if len(failures) > 0 {
+			if err := stream.Send(&pb.NotificationAck{Failures: failures}); err != nil {
+				slog.Warn("Failed to report notification failures", "count", len(failures), "error", err)
+				return err
+			}
+		}
+	}
+}
+
+// leaveRoomByServer 战斗服关闭房间或移出玩家后清除玩家所在房间
+func (p *Player) leaveRoomByServer(roomID, reason string) {
+	if !p.clearRoomID(roomID) {
+		return
+	}
+	slog.Info("Player removed from room by battle server", "player_id", p.Uid, "room_id", roomID, "reason", reason)
+}
+
+// deliverNotification 将一条流式通知放入玩家的发送队列
+func deliverNotification(notification *pb.PlayerNotification) pb.ErrorCode {
+	ctx, span := notificationContext(notification)
+	defer span.End()
+
+	player, ok := GlobalManager.GetPlayerByUin(notification.BeNotifiedUid)
+	if !ok {
+		span.SetErrorMessage(pb.ErrorCode_PLAYER_OFFLINE.String())
+		return pb.ErrorCode_PLAYER_OFFLINE
+	}
+
+	// 房间被回收或玩家被移出房间后，玩家不再属于该房间
+	switch notification.MsgId {
+	case pb.MessageId_ROOM_CLOSED_NOTIFICATION:
+		var closed pb.RoomClosedNotification
+		if err := proto.Unmarshal(notification.Data, &closed); err == nil {
+			player.leaveRoomByServer(closed.RoomId, closed.Reason.String())
+		}
+	case pb.MessageId_KICKED_FROM_ROOM_NOTIFICATION:
+		var kicked pb.KickedFromRoomNotification
+		if err := proto.Unmarshal(notification.Data, &kicked); err == nil {
+			player.leaveRoomByServer(kicked.RoomId, kicked.Reason.String())
+		}
+	}
+
+	noti := &pb.Message{
+		Id:          notification.MsgId,
+		MsgSerialNo: -1,
+		ClientId:    "",
+		Data:        notification.Data,
+	}
+
+	if !player.Push(noti) {
+		slog.WarnContext(ctx, "Player send queue full, notification dropped", "player_id", notification.BeNotifiedUid,
+			"msg_id", notification.MsgId)
+		span.SetErrorMessage(pb.ErrorCode_SERVER_BUSY.String())
+		return pb.ErrorCode_SERVER_BUSY
+	}
+	return pb.ErrorCode_OK
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"common/tlsutil"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// LoadGatewayTLSFromEnv 读取 TCP 和 WebSocket 端口的 TLS 配置，未配置证书时返回 nil（明文）
+//   - GAME_TLS_CERT_FILE / GAME_TLS_KEY_FILE：证书和私钥（PEM），需同时配置
+//   - GAME_TLS_RELOAD_INTERVAL：检查证书文件更新的间隔，默认 1m，证书续期后无需重启
+func LoadGatewayTLSFromEnv(ctx context.Context) (*tls.Config, error) {
+	certFile, keyFile := os.Getenv("GAME_TLS_CERT_FILE"), os.Getenv("GAME_TLS_KEY_FILE")
+	if certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("GAME_TLS_CERT_FILE and GAME_TLS_KEY_FILE must be set together")
+	}
+
+	reloader, err := tlsutil.NewCertReloader(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	interval := loadDurationFromEnv("GAME_TLS_RELOAD_INTERVAL", time.Minute)
+	go reloader.Watch(ctx, interval)
+
+	slog.Info("Gateway TLS enabled", "cert_file", certFile, "reload_interval", interval)
+	return reloader.TLSConfig(), nil
+}
+
+// OriginPolicy WebSocket 握手的 Origin 白名单
+// 条目为完整的源（https://game.example.com）或子域名通配（https://*.example.com），
+// 没有 Origin 头的请求（原生客户端、小游戏平台）不受限制
+type OriginPolicy struct {
+	allowAll bool
+	origins  map[string]bool // scheme://host[:port]
+	suffixes []string        // 通配条目，如 "https://" + ".example.com"，按 scheme 和域名后缀匹配
+}
+
+// GlobalOriginPolicy 在 main 中根据 GAME_WS_ALLOWED_ORIGINS 初始化
+var GlobalOriginPolicy = &OriginPolicy{allowAll: true}
+
+// ParseOriginPolicy 解析逗号分隔的白名单，为空时允许所有来源
+func ParseOriginPolicy(s string) (*OriginPolicy, error) {
+	policy := &OriginPolicy{origins: make(map[string]bool)}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch {
+		case entry == "":
+			continue
+		case entry == "*":
+			policy.allowAll = true
+			continue
+		}
+
+		u, err := url.Parse(entry)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			return nil, fmt.Errorf("invalid origin %q", entry)
+		}
+		if host, ok := strings.CutPrefix(u.Host, "*."); ok {
+			policy.suffixes = append(policy.suffixes, u.Scheme+"://."+host)
+		} else {
+			policy.origins[u.Scheme+"://"+u.Host] = true
+		}
+	}
+	if len(policy.origins) == 0 && len(policy.suffixes) == 0 {
+		policy.allowAll = true
+	}
+	return policy, nil
+}
+
+// Allowed Origin 是否在白名单中
+func (o *OriginPolicy) Allowed(origin string) bool {
+	if o.allowAll || origin == "" {
+		return true
+	}
+	u, err := url.Parse(strings.ToLower(origin))
+	if err != nil || u.Host == "" {
+		return false
+	}
+	if o.origins[u.Scheme+"://"+u.Host] {
+		return true
+	}
+	for _, suffix := range o.suffixes {
+		scheme, domain, _ := strings.Cut(suffix, "://")
+		if u.Scheme == scheme && strings.HasSuffix(u.Host, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckOrigin 用于 websocket.Upgrader.CheckOrigin，拒绝时记录安全日志
+func (o *OriginPolicy) CheckOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if o.Allowed(origin) {
+		return true
+	}
+	wsOriginRejected.Inc()
+	logSecurityEvent("WebSocket origin rejected", "origin", origin, "remote_addr", r.RemoteAddr)
+	return false
+}
@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestParseOriginPolicy(t *testing.T) {
+	for _, bad := range []string{"game.example.com", "https://", "https://game.example.com/path", "://x"} {
+		if _, err := ParseOriginPolicy(bad); err == nil {
+			t.Errorf("ParseOriginPolicy(%q) accepted", bad)
+		}
+	}
+}
+
+func TestOriginPolicyAllowed(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		origin string
+		want   bool
+	}{
+		{"empty list allows all", "", "https://evil.com", true},
+		{"star allows all", "*, https://game.example.com", "https://evil.com", true},
+		{"no origin header", "https://game.example.com", "", true},
+		{"exact match", "https://game.example.com", "https://game.example.com", true},
+		{"case insensitive", "https://Game.Example.com", "HTTPS://game.EXAMPLE.com", true},
+		{"trailing slash in entry", "https://game.example.com/", "https://game.example.com", true},
+		{"port must match", "https://game.example.com:8443", "https://game.example.com", false},
+		{"explicit port", "https://game.example.com:8443", "https://game.example.com:8443", true},
+		{"scheme must match", "https://game.example.com", "http://game.example.com", false},
+		{"other host", "https://game.example.com", "https://evil.com", false},
+		{"wildcard subdomain", "https://*.example.com", "https://a.b.example.com", true},
+		{"wildcard excludes apex", "https://*.example.com", "https://example.com", false},
+		{"wildcard suffix boundary", "https://*.example.com", "https://evilexample.com", false},
+		{"wildcard scheme", "https://*.example.com", "http://a.example.com", false},
+		{"several entries", "https://a.com, https://b.com", "https://b.com", true},
+		{"malformed origin", "https://game.example.com", "null", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParseOriginPolicy(tt.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := policy.Allowed(tt.origin); got != tt.want {
+				t.Fatalf("Allowed(%q) with %q = %v, want %v", tt.origin, tt.policy, got, tt.want)
+			}
+		})
+	}
+}
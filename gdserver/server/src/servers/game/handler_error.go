@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	pb "proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HandlerError 消息处理函数返回的业务错误，由 respondErrors 中间件转换为带错误码的响应
+type HandlerError struct {
+	Code    pb.ErrorCode
+	Message string // 响应中有 error_msg 字段时返回给客户端
+}
+
+func (e *HandlerError) Error() string {
+	if e.Message == "" {
+		return e.Code.String()
+	}
+	return e.Code.String() + ": " + e.Message
+}
+
+// NewHandlerError 创建业务错误
+func NewHandlerError(code pb.ErrorCode, format string, args ...any) *HandlerError {
+	return &HandlerError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// errorCodeOf 错误对应的错误码：HandlerError 使用其错误码，超时（包括下游 RPC 超时）为 TIMEOUT，其他为 SERVER_ERROR
+func errorCodeOf(err error) (pb.ErrorCode, string) {
+	var handlerErr *HandlerError
+	switch {
+	case errors.As(err, &handlerErr):
+		return handlerErr.Code, handlerErr.Message
+	case errors.Is(err, context.DeadlineExceeded), status.Code(err) == codes.DeadlineExceeded:
+		return pb.ErrorCode_TIMEOUT, ""
+	default:
+		// 内部错误的详细信息只写日志，不返回给客户端
+		return pb.ErrorCode_SERVER_ERROR, ""
+	}
+}
+
+// errorResponse 构造只包含错误码（和 error_msg）的响应
+// 响应类型按 payloadTypes 中请求ID+1 的类型确定，没有响应或响应中没有 ret 字段（如心跳）时返回 nil
+func errorResponse(srcMsg *pb.Message, code pb.ErrorCode, errMsg string) *pb.Message {
+	newPayload, ok := payloadTypes[srcMsg.GetId()+1]
+	if !ok {
+		return nil
+	}
+	payload := newPayload().ProtoReflect()
+	fields := payload.Descriptor().Fields()
+	field := fields.ByName("ret")
+	if field == nil {
+		return nil
+	}
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		payload.Set(field, protoreflect.ValueOfEnum(protoreflect.EnumNumber(code)))
+	case protoreflect.Int32Kind:
+		payload.Set(field, protoreflect.ValueOfInt32(int32(code)))
+	default:
+		return nil
+	}
+	if msgField := fields.ByName("error_msg"); msgField != nil && msgField.Kind() == protoreflect.StringKind && errMsg != "" {
+		payload.Set(msgField, protoreflect.ValueOfString(errMsg))
+	}
+
+	data, err := proto.Marshal(payload.Interface())
+	if err != nil {
+		slog.Error("Failed to marshal error response", "msg_id", srcMsg.GetId(), "error", err)
+		return nil
+	}
+	return newResponse(srcMsg, data)
+}
+
+// sendErrorResponse 回复错误响应（用于消息处理协程）
+func (p *Player) sendErrorResponse(srcMsg *pb.Message, code pb.ErrorCode, errMsg string) {
+	if rsp := errorResponse(srcMsg, code, errMsg); rsp != nil {
+		p.SendMessage(rsp)
+	}
+}
+
+// trySendErrorResponse 回复只包含错误码的响应，不阻塞（用于读协程）
+func (p *Player) trySendErrorResponse(srcMsg *pb.Message, code pb.ErrorCode) {
+	if rsp := errorResponse(srcMsg, code, ""); rsp != nil {
+		p.TrySendMessage(rsp)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"log/slog"
+	"net"
+	"os"
+	pb "proto"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ReadTimeout 连接读超时：超过该时间没有收到任何数据（业务消息、心跳或 WebSocket ping）则断开连接，
+// 走正常的退出清理（离开房间、退出匹配队列）。客户端的心跳间隔应明显小于该值
+var ReadTimeout = loadDurationFromEnv("GAME_READ_TIMEOUT", 60*time.Second)
+
+// WriteTimeout 单次写超时，对端不再读取时发送协程不会一直阻塞
+const WriteTimeout = 10 * time.Second
+
+// extendReadDeadline 收到数据后延长读超时
+func (p *Player) extendReadDeadline() {
+	if err := p.Conn.SetReadDeadline(time.Now().Add(ReadTimeout)); err != nil {
+		slog.Warn("Failed to set read deadline", "conn_uuid", p.ConnUUID, "error", err)
+	}
+}
+
+// isReadTimeout 读取失败是否是读超时导致的
+func isReadTimeout(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, os.ErrDeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+}
+
+// HandleHeartbeatRequest 心跳，返回服务器时间用于客户端校准时钟；会话到期后在心跳中重新校验
+func (p *Player) HandleHeartbeatRequest(msg *pb.Message) error {
+	var req pb.HeartbeatRequest
+	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid HeartbeatRequest: %v", err)
+	}
+	p.ackPush(req.AckPushSeq)
+	if !p.checkSessionExpiry() {
+		return nil
+	}
+
+	p.SendResponse(msg, mustMarshal(&pb.HeartbeatResponse{
+		ClientTime: req.ClientTime,
+		ServerTime: time.Now().UnixMilli(),
+	}))
+	return nil
+}
@@ -1,7 +1,12 @@
 package main
 
 import (
+	"common/discovery"
+	"common/metrics"
 	"common/redisutil"
+	"common/tracing"
+	"context"
+	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"net"
@@ -45,11 +50,14 @@ func handleConnection(conn Connection) {
 
 func main() {
 	// 初始化全局Logger（JSON格式，级别为Debug）
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	logger := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelDebug,
-	}))
+	})))
 	slog.SetDefault(logger) // 设为全局默认Logger
 
+	// 初始化调用链追踪（TRACE_EXPORTER 为空时不导出）
+	defer tracing.Init("game")(context.Background())
+
 	// 初始化Redis连接池
 	redisConfig := redisutil.LoadRedisConfigFromEnv()
 	GlobalRedis = redisutil.NewRedisPoolFromConfig(redisConfig)
@@ -60,6 +68,50 @@ func main() {
 		os.Exit(1)
 	}
 
+	// 加载抽卡配置
+	if err := InitCardService(); err != nil {
+		slog.Error("Failed to load card config", "error", err)
+		os.Exit(1)
+	}
+
+	// 认证请求签名校验
+	verifier, err := LoadAuthVerifierFromEnv()
+	if err != nil {
+		slog.Error("Invalid auth signature config", "error", err)
+		os.Exit(1)
+	}
+	GlobalAuthVerifier = verifier
+	slog.Info("Auth signature verification", "mode", verifier.Mode, "apps", len(verifier.Secrets), "max_skew", verifier.MaxSkew)
+
+	// 网关 TLS 和 WebSocket Origin 白名单
+	tlsConfig, err := LoadGatewayTLSFromEnv(context.Background())
+	if err != nil {
+		slog.Error("Invalid gateway TLS config", "error", err)
+		os.Exit(1)
+	}
+	originPolicy, err := ParseOriginPolicy(os.Getenv("GAME_WS_ALLOWED_ORIGINS"))
+	if err != nil {
+		slog.Error("Invalid GAME_WS_ALLOWED_ORIGINS", "error", err)
+		os.Exit(1)
+	}
+	GlobalOriginPolicy = originPolicy
+	if originPolicy.allowAll {
+		slog.Warn("WebSocket origin check disabled, set GAME_WS_ALLOWED_ORIGINS to restrict browser clients")
+	}
+
+	// 订阅会话撤销广播（登出、运维撤销）
+	if err := SubscribeSessionRevocations(); err != nil {
+		slog.Error("Failed to subscribe session revocations", "error", err)
+		os.Exit(1)
+	}
+
+	// 启动指标服务
+	metrics.Serve(fmt.Sprintf(":%d", MetricsPort))
+
+	// 初始化战斗服路由
+	GlobalBattleRouter = discovery.NewBattleRouter(GlobalRedis, "prod_")
+	GlobalMatchDiscovery = discovery.NewRedisDiscovery(GlobalRedis, "prod_")
+
 	//启动 grpc
 	service := &GameGRPCService{}
 
@@ -67,26 +119,36 @@ func main() {
 	go service.StartGameGRPCService()
 
 	// 启动TCP服务器
-	go startTCPServer()
+	go startTCPServer(tlsConfig)
 
-	// 启动HTTP/WebSocket服务器
+	// 启动HTTP/WebSocket服务器，配置证书时为 wss://
 	http.HandleFunc("/ws", handleWebSocket)
-	slog.Info("Starting WebSocket server", "port", 18080)
-	if err := http.ListenAndServe(":18080", nil); err != nil {
+	slog.Info("Starting WebSocket server", "port", 18080, "tls", tlsConfig != nil)
+	server := &http.Server{Addr: ":18080", TLSConfig: tlsConfig}
+	if tlsConfig != nil {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil {
 		slog.Error("Failed to start HTTP server", "error", err)
 		os.Exit(1)
 	}
 }
 
-func startTCPServer() {
+// tlsConfig 不为 nil 时使用 TLS，握手在第一次读取时进行，受读超时限制
+func startTCPServer(tlsConfig *tls.Config) {
 	listener, err := net.Listen("tcp", ":12345")
 	if err != nil {
 		slog.Error("Failed to start TCP server", "error", err)
 		return
 	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
 	defer listener.Close()
 
-	slog.Info("TCP server started", "port", 12345)
+	slog.Info("TCP server started", "port", 12345, "tls", tlsConfig != nil)
 
 	for {
 		conn, err := listener.Accept()
@@ -94,7 +156,7 @@ func startTCPServer() {
 			slog.Error("Failed to accept TCP connection", "error", err)
 			continue
 		}
-		go handleConnection(conn)
+		go handleConnection(NewTCPConnection(conn, ProtoCodec{}))
 	}
 }
 
@@ -25,15 +25,20 @@ func (rm *Manager) GetOrCreatePlayer(connUUID string, conn Connection) *Player {
 	return player.(*Player)
 }
 
-func (m *Manager) OnPlayerUinSet(connUUID string) {
+// OnPlayerUinSet 绑定 uid 到连接，返回该 uid 之前绑定的其他连接（重复登录），没有时返回 nil
+func (m *Manager) OnPlayerUinSet(connUUID string) *Player {
 	player, ok := m.players.Load(connUUID)
 	if !ok {
 		slog.Info("Player connection uuid not found", "conn_uuid", connUUID)
-		return
+		return nil
 	}
 
 	// 将玩家的 Uid 存储到 uin_player 中
-	m.uin_player.Store(player.(*Player).Uid, player)
+	previous, loaded := m.uin_player.Swap(player.(*Player).Uid, player)
+	if !loaded || previous == player {
+		return nil
+	}
+	return previous.(*Player)
 }
 
 // 获取玩家
@@ -60,11 +65,37 @@ func (rm *Manager) GetPlayerByUin(uin uint64) (*Player, bool) {
 func (rm *Manager) DeletePlayer(id string) {
 	if player, ok := rm.GetPlayer(id); ok {
 		rm.players.Delete(id) // 删除玩家
-		rm.uin_player.Delete(player.Uid)
+		// 重复登录时 uid 已绑定到新连接，只删除仍指向本连接的映射
+		rm.uin_player.CompareAndDelete(player.Uid, player)
 		slog.Info("Player deleted", "conn_uuid", id)
 	}
 }
 
+// GetPlayersBySession 使用指定会话认证的玩家（同一会话可能在多个连接上使用）
+func (rm *Manager) GetPlayersBySession(sessionID string) []*Player {
+	var players []*Player
+	rm.uin_player.Range(func(key, value interface{}) bool {
+		if player := value.(*Player); player.SessionID == sessionID {
+			players = append(players, player)
+		}
+		return true
+	})
+	return players
+}
+
+// Counts 当前连接数和已认证（已绑定uin）的玩家数
+func (rm *Manager) Counts() (connected, authenticated int) {
+	rm.players.Range(func(key, value interface{}) bool {
+		connected++
+		return true
+	})
+	rm.uin_player.Range(func(key, value interface{}) bool {
+		authenticated++
+		return true
+	})
+	return connected, authenticated
+}
+
 // 获取所有玩家
 func (rm *Manager) GetAllPlayers() []*Player {
 	var players []*Player
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"common/metrics"
+	pb "proto"
+	"strconv"
+	"time"
+)
+
+// MetricsPort GameServer 指标端口，可通过 METRICS_ADDR 覆盖
+const MetricsPort = 9102
+
+var (
+	messagesTotal = metrics.NewCounterVec("game_messages_total",
+		"按消息ID统计收到的客户端消息数", "msg_id")
+	handlerDuration = metrics.NewHistogramVec("game_handler_duration_seconds",
+		"按消息ID统计的消息处理耗时", nil, "msg_id")
+	handlerErrors = metrics.NewCounterVec("game_handler_errors_total",
+		"按消息ID和错误码统计处理函数返回的错误数", "msg_id", "ret")
+	handlerPanics = metrics.NewCounterVec("game_handler_panics_total",
+		"按消息ID统计处理函数 panic 次数", "msg_id")
+	handlerRejected = metrics.NewCounterVec("game_handler_rejected_total",
+		"按消息ID统计工作池或玩家队列已满被拒绝（回复 SERVER_BUSY）的消息数", "msg_id")
+	recvChanDropped = metrics.NewCounter("game_recv_chan_dropped_total",
+		"玩家接收队列已满被丢弃的消息数")
+	idleDisconnects = metrics.NewCounter("game_idle_disconnects_total",
+		"读超时（心跳超时）被断开的连接数")
+	notifyFailures = metrics.NewCounterVec("game_notify_failures_total",
+		"战斗服/匹配服通知投递失败次数", "rpc", "ret")
+	rateLimited = metrics.NewCounterVec("game_rate_limited_total",
+		"按消息ID统计被限流的消息数", "msg_id")
+	rateLimitDisconnects = metrics.NewCounter("game_rate_limit_disconnects_total",
+		"频繁超过限流被断开的连接数")
+	authSignatureFailures = metrics.NewCounterVec("game_auth_signature_failures_total",
+		"认证请求签名/时间戳/随机数校验失败次数", "ret")
+	duplicateLogins = metrics.NewCounter("game_duplicate_logins_total",
+		"同一账号在新连接登录、顶替旧连接的次数")
+	wsOriginRejected = metrics.NewCounter("game_ws_origin_rejected_total",
+		"Origin 不在白名单中被拒绝的 WebSocket 握手数")
+	sessionRevocations = metrics.NewCounterVec("game_session_revocations_total",
+		"因会话撤销（运维撤销/登出）或过期被断开的连接数", "reason")
+	pushResent = metrics.NewCounter("game_push_resent_total",
+		"重连后补发的推送数")
+	pushResyncs = metrics.NewCounter("game_push_resyncs_total",
+		"重连后无法补发、要求客户端全量同步的次数")
+	pushOverflowDisconnects = metrics.NewCounter("game_push_overflow_disconnects_total",
+		"推送时发送队列已满被断开（重连后补发）的连接数")
+	gachaDraws = metrics.NewCounterVec("game_gacha_draws_total",
+		"按稀有度统计的抽卡数", "rarity")
+)
+
+func init() {
+	metrics.NewGaugeFunc("game_players_connected", "当前连接数（包含未认证）", func() float64 {
+		connected, _ := GlobalManager.Counts()
+		return float64(connected)
+	})
+	metrics.NewGaugeFunc("game_handler_queue_pending", "工作池中等待执行的任务数", func() float64 {
+		return float64(GlobalWorkerPool.Pending())
+	})
+	metrics.NewGaugeFunc("game_players_authenticated", "当前已认证的玩家数", func() float64 {
+		_, authenticated := GlobalManager.Counts()
+		return float64(authenticated)
+	})
+}
+
+// observeMessage 记录消息数和处理耗时
+func observeMessage(msgID pb.MessageId, start time.Time) {
+	name := msgID.String()
+	messagesTotal.WithLabelValues(name).Inc()
+	handlerDuration.WithLabelValues(name).ObserveSince(start)
+}
+
+// observeNotifyFailure 记录通知投递失败
+func observeNotifyFailure(rpc string, ret pb.ErrorCode) {
+	notifyFailures.WithLabelValues(rpc, ret.String()).Inc()
+}
+
+// observeGachaDraws 按稀有度记录抽卡结果
+func observeGachaDraws(cards []*pb.Card) {
+	for _, card := range cards {
+		gachaDraws.WithLabelValues(strconv.Itoa(int(card.GetRarity()))).Inc()
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	pb "proto"
+	"runtime/debug"
+	"time"
+)
+
+// HandlerFunc 消息处理函数，返回的错误由 respondErrors 转换为带错误码的响应（处理函数自己不再回复）
+type HandlerFunc func(p *Player, msg *pb.Message) error
+
+// Middleware 包装消息处理函数
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Chain 依次用中间件包装处理函数，第一个中间件在最外层
+func Chain(handler HandlerFunc, middlewares ...Middleware) HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+// DefaultHandlerTimeout 单条消息的处理时限，处理期间的下游 RPC 继承该时限（见 requestContext）
+var DefaultHandlerTimeout = loadDurationFromEnv("GAME_HANDLER_TIMEOUT", 10*time.Second)
+
+// traceMessage 为每条消息开始调用链，处理失败时标记 span
+func traceMessage(next HandlerFunc) HandlerFunc {
+	return func(p *Player, msg *pb.Message) error {
+		span := p.startMessageSpan(msg)
+		defer p.endMessageSpan(msg, span)
+		err := next(p, msg)
+		span.SetError(err)
+		return err
+	}
+}
+
+// logMessage 记录消息数、处理耗时和处理结果
+func logMessage(next HandlerFunc) HandlerFunc {
+	return func(p *Player, msg *pb.Message) error {
+		start := time.Now()
+		err := next(p, msg)
+		observeMessage(msg.GetId(), start)
+
+		attrs := []any{"msg_id", msg.GetId(), "msg_serial_no", msg.GetMsgSerialNo(), "player_id", p.Uid,
+			"conn_uuid", p.ConnUUID, "duration", time.Since(start)}
+		if err != nil {
+			slog.WarnContext(p.messageContext(msg), "Message handled with error", append(attrs, "error", err)...)
+		} else {
+			slog.InfoContext(p.messageContext(msg), "Message handled", attrs...)
+		}
+		return err
+	}
+}
+
+// respondErrors 处理函数返回错误时回复对应错误码
+func respondErrors(next HandlerFunc) HandlerFunc {
+	return func(p *Player, msg *pb.Message) error {
+		err := next(p, msg)
+		if err != nil {
+			code, errMsg := errorCodeOf(err)
+			handlerErrors.WithLabelValues(msg.GetId().String(), code.String()).Inc()
+			p.sendErrorResponse(msg, code, errMsg)
+		}
+		return err
+	}
+}
+
+// recoverPanic 处理函数 panic 时记录堆栈并返回 SERVER_ERROR，玩家的消息处理协程继续运行
+func recoverPanic(next HandlerFunc) HandlerFunc {
+	return func(p *Player, msg *pb.Message) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				handlerPanics.WithLabelValues(msg.GetId().String()).Inc()
+				slog.ErrorContext(p.messageContext(msg), "Message handler panic", "msg_id", msg.GetId(), "player_id", p.Uid,
+					"conn_uuid", p.ConnUUID, "panic", r, "stack", string(debug.Stack()))
+				err = NewHandlerError(pb.ErrorCode_SERVER_ERROR, "internal error")
+			}
+		}()
+		return next(p, msg)
+	}
+}
+
+// requireAuth 未认证的连接只能发送注册为 Public 的消息
+func requireAuth(next HandlerFunc) HandlerFunc {
+	return func(p *Player, msg *pb.Message) error {
+		if !p.Authenticated {
+			slog.Warn("Unauthenticated player attempted to send message", "conn_uuid", p.ConnUUID, "msg_id", msg.GetId())
+			return NewHandlerError(pb.ErrorCode_AUTH_FAILED, "not authenticated")
+		}
+		return next(p, msg)
+	}
+}
+
+// withDeadline 为处理过程设置截止时间，处理函数中通过 requestContext 发起的 RPC 到期后被取消，
+// 处理函数收到 DeadlineExceeded 错误后返回，由 respondErrors 回复 TIMEOUT；不调用 RPC 的处理逻辑不会被中断，超过时限只记录日志
+func withDeadline(timeout time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(p *Player, msg *pb.Message) error {
+			ctx, cancel := context.WithTimeout(p.messageContext(msg), timeout)
+			p.setMessageContext(msg, ctx)
+			defer cancel()
+
+			err := next(p, msg)
+			if err == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				slog.WarnContext(ctx, "Message handler exceeded deadline", "msg_id", msg.GetId(), "player_id", p.Uid,
+					"timeout", timeout)
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "proto"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRespondErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      pb.MessageId
+		err     error
+		wantRet pb.ErrorCode
+		wantMsg string
+		noReply bool
+	}{
+		{"handler error with message", pb.MessageId_AUTH_REQUEST, NewHandlerError(pb.ErrorCode_AUTH_FAILED, "bad token"),
+			pb.ErrorCode_AUTH_FAILED, "bad token", false},
+		{"wrapped handler error", pb.MessageId_MATCH_REQUEST,
+			fmt.Errorf("match: %w", NewHandlerError(pb.ErrorCode_PLAYER_ALREADY_IN_ROOM, "")), pb.ErrorCode_PLAYER_ALREADY_IN_ROOM, "", false},
+		{"internal error hides details", pb.MessageId_AUTH_REQUEST, errors.New("redis: connection refused"),
+			pb.ErrorCode_SERVER_ERROR, "", false},
+		{"context deadline", pb.MessageId_MATCH_REQUEST, fmt.Errorf("rpc: %w", context.DeadlineExceeded), pb.ErrorCode_TIMEOUT, "", false},
+		{"grpc deadline", pb.MessageId_MATCH_REQUEST, status.Error(codes.DeadlineExceeded, "deadline"), pb.ErrorCode_TIMEOUT, "", false},
+		{"success", pb.MessageId_MATCH_REQUEST, nil, 0, "", true},
+		{"response without ret", pb.MessageId_HEARTBEAT_REQUEST, errors.New("boom"), 0, "", true},
+		{"message without response", pb.MessageId_PUSH_ACK, errors.New("boom"), 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer()
+			handler := Chain(func(*Player, *pb.Message) error { return tt.err }, respondErrors)
+			if err := handler(p, testMsg(tt.id, 7)); err != tt.err {
+				t.Fatalf("returned %v, want %v", err, tt.err)
+			}
+
+			rsp := nextResponse(p)
+			if tt.noReply {
+				if rsp != nil {
+					t.Fatalf("unexpected response %v", rsp.GetId())
+				}
+				return
+			}
+			if rsp == nil {
+				t.Fatal("no response")
+			}
+			if rsp.GetId() != tt.id+1 || rsp.GetMsgSerialNo() != 7 {
+				t.Fatalf("response id %v serial %d, want %v serial 7", rsp.GetId(), rsp.GetMsgSerialNo(), tt.id+1)
+			}
+			payload := payloadTypes[rsp.GetId()]()
+			if err := proto.Unmarshal(rsp.GetData(), payload); err != nil {
+				t.Fatal(err)
+			}
+			fields := payload.ProtoReflect().Descriptor().Fields()
+			if ret := pb.ErrorCode(payload.ProtoReflect().Get(fields.ByName("ret")).Enum()); ret != tt.wantRet {
+				t.Errorf("ret = %v, want %v", ret, tt.wantRet)
+			}
+			if f := fields.ByName("error_msg"); f != nil {
+				if got := payload.ProtoReflect().Get(f).String(); got != tt.wantMsg {
+					t.Errorf("error_msg = %q, want %q", got, tt.wantMsg)
+				}
+			}
+		})
+	}
+}
+
+func TestRecoverPanic(t *testing.T) {
+	p := newTestPlayer()
+	calls := 0
+	handler := Chain(func(*Player, *pb.Message) error {
+		calls++
+		if calls == 1 {
+			panic("boom")
+		}
+		return nil
+	}, respondErrors, recoverPanic)
+
+	err := handler(p, testMsg(pb.MessageId_MATCH_REQUEST, 1))
+	var handlerErr *HandlerError
+	if !errors.As(err, &handlerErr) || handlerErr.Code != pb.ErrorCode_SERVER_ERROR {
+		t.Fatalf("panic returned %v, want SERVER_ERROR", err)
+	}
+	rsp := nextResponse(p)
+	var match pb.MatchResponse
+	if rsp == nil || proto.Unmarshal(rsp.GetData(), &match) != nil || match.Ret != pb.ErrorCode_SERVER_ERROR {
+		t.Fatalf("panic response = %v, want MATCH_RESPONSE SERVER_ERROR", rsp)
+	}
+
+	// 之后的消息照常处理
+	if err := handler(p, testMsg(pb.MessageId_MATCH_REQUEST, 2)); err != nil || calls != 2 {
+		t.Fatalf("second call: err=%v calls=%d", err, calls)
+	}
+	if rsp := nextResponse(p); rsp != nil {
+		t.Fatalf("unexpected response after recovery: %v", rsp.GetId())
+	}
+}
+
+func TestRequireAuth(t *testing.T) {
+	p := NewPlayer("auth-test", nil)
+	called := false
+	handler := Chain(func(*Player, *pb.Message) error { called = true; return nil }, requireAuth)
+
+	err := handler(p, testMsg(pb.MessageId_MATCH_REQUEST, 1))
+	var handlerErr *HandlerError
+	if !errors.As(err, &handlerErr) || handlerErr.Code != pb.ErrorCode_AUTH_FAILED || called {
+		t.Fatalf("unauthenticated: err=%v called=%v", err, called)
+	}
+
+	p.Authenticated = true
+	if err := handler(p, testMsg(pb.MessageId_MATCH_REQUEST, 2)); err != nil || !called {
+		t.Fatalf("authenticated: err=%v called=%v", err, called)
+	}
+}
+
+// TestWithDeadline 下游 RPC 的 ctx 不超过消息的处理时限
+func TestWithDeadline(t *testing.T) {
+	p := newTestPlayer()
+	const limit = 50 * time.Millisecond
+
+	handler := Chain(func(p *Player, msg *pb.Message) error {
+		ctx, cancel := p.requestContext(msg, time.Hour)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		if !ok || time.Until(deadline) > limit {
+			t.Errorf("request deadline in %v, want within %v", time.Until(deadline), limit)
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}, respondErrors, withDeadline(limit))
+
+	start := time.Now()
+	if err := handler(p, testMsg(pb.MessageId_MATCH_REQUEST, 1)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("handler ran %v after the deadline", elapsed)
+	}
+	var match pb.MatchResponse
+	if rsp := nextResponse(p); rsp == nil || proto.Unmarshal(rsp.GetData(), &match) != nil || match.Ret != pb.ErrorCode_TIMEOUT {
+		t.Fatalf("response = %v, want MATCH_RESPONSE TIMEOUT", rsp)
+	}
+}
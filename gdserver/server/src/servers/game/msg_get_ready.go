@@ -1,18 +1,15 @@
 package main
 
 import (
-	"context"
-	"log"
+	"fmt"
 	"log/slog"
 	pb "proto"
 	"time"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
 )
 
-func (p *Player) HandleGetReadyRequest(msg *pb.Message) {
+func (p *Player) HandleGetReadyRequest(msg *pb.Message) error {
 
 	defer func() {
 		slog.Info("HandleGetReadyRequest completed", "playerId", p.Uid)
@@ -20,26 +17,15 @@ func (p *Player) HandleGetReadyRequest(msg *pb.Message) {
 
 	var req pb.GetReadyRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse GetReadyRequest Request", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid GetReadyRequest: %v", err)
 	}
 
-	conn, err := grpc.Dial(
-		"127.0.0.1:8693",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
+	client, err := battleClient(p.RoomID())
 	if err != nil {
-		log.Printf("连接BattleServer失败: %s, 错误: %v", "127.0.0.1:8693", err)
-		return
+		return fmt.Errorf("connect to BattleServer: %w", err)
 	}
 
-	defer conn.Close()
-
-	client := pb.NewRoomRpcServiceClient(conn)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
 	defer cancel()
 
 	getReadyRpc := &pb.GetReadyRpcRequest{
@@ -49,8 +35,7 @@ func (p *Player) HandleGetReadyRequest(msg *pb.Message) {
 
 	resp, err := client.GetReadyRpc(ctx, getReadyRpc)
 	if err != nil {
-		slog.Error("房间内准备RPC调用失败: ", "error", err)
-		return
+		return fmt.Errorf("GetReadyRpc: %w", err)
 	}
 
 	if resp.Ret != pb.ErrorCode_OK {
@@ -61,4 +46,5 @@ func (p *Player) HandleGetReadyRequest(msg *pb.Message) {
 	p.SendResponse(msg, mustMarshal(&pb.GetReadyResponse{
 		Ret: resp.Ret,
 	}))
+	return nil
 }
@@ -1,76 +1,129 @@
 package main
 
 import (
+	"common/roomlist"
 	"context"
-	"log"
 	"log/slog"
 	pb "proto"
+	"sort"
+	"sync"
 	"time"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
 )
 
-func (p *Player) HandleGetRoomListRequest(msg *pb.Message) {
+func (p *Player) HandleGetRoomListRequest(msg *pb.Message) error {
 	slog.Info("HandleGetRoomListRequest called", "player_id", p.Uid, "message_id", msg.GetId())
 
 	var req pb.GetRoomListRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse GetRoomListRequest", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid GetRoomListRequest: %v", err)
 	}
 
-	slog.Info("GetRoomListRequest parsed", "player_id", p.Uid)
+	slog.Info("GetRoomListRequest parsed", "player_id", p.Uid, "filter", &req)
 
-	// 创建gRPC client并连接到battle server
-	slog.Info("Attempting to connect to BattleServer", "address", "127.0.0.1:8693")
-	conn, err := grpc.Dial(
-		"127.0.0.1:8693",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
-	if err != nil {
-		log.Printf("连接BattleServer失败: %s, 错误: %v", "127.0.0.1:8693", err)
-		slog.Error("Failed to connect to BattleServer", "error", err)
-		// 即使连接失败，也应该给客户端发送响应
-		p.SendResponse(msg, mustMarshal(&pb.GetRoomListResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+	// 房间分布在多个BattleServer上，向所有实例请求同一页后合并
+	addrs := battleServerAddrs()
+	results := make([]*pb.GetRoomListRpcResponse, len(addrs))
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	for i, addr := range addrs {
+		wg.Add(1)
+		go func(i int, addr string) {
+			defer wg.Done()
+			results[i] = getRoomListFrom(ctx, addr, &req)
+		}(i, addr)
 	}
+	wg.Wait()
 
-	slog.Info("Connected to BattleServer successfully")
-	defer conn.Close()
+	resp := mergeRoomLists(&req, results)
 
-	client := pb.NewRoomRpcServiceClient(conn)
+	slog.Info("GetRoomListRpc response", "player_id", p.Uid, "ret", resp.GetRet(), "room_count", len(resp.GetRooms()),
+		"battle_servers", len(addrs))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
+	if resp.Ret != pb.ErrorCode_OK {
+		slog.Error("获取房间列表失败，错误码: ", "error_code", resp.Ret)
+	}
 
-	// 调用获取房间列表的RPC方法
-	getRoomListReq := &pb.GetRoomListRpcRequest{}
-	slog.Info("Calling GetRoomListRpc", "player_id", p.Uid)
-	resp, err := client.GetRoomListRpc(ctx, getRoomListReq)
+	// 返回房间列表给客户端
+	p.SendResponse(msg, mustMarshal(resp))
+	return nil
+}
+
+// getRoomListFrom 从单个BattleServer获取一页房间，失败返回nil
+func getRoomListFrom(ctx context.Context, addr string, filter *pb.GetRoomListRequest) *pb.GetRoomListRpcResponse {
+	client, err := battleClientAt(addr)
 	if err != nil {
-		slog.Error("获取房间列表RPC调用失败: ", "error", err)
-		// 即使RPC调用失败，也应该给客户端发送响应
-		p.SendResponse(msg, mustMarshal(&pb.GetRoomListResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		slog.Error("Failed to connect to BattleServer", "address", addr, "error", err)
+		return nil
 	}
 
-	slog.Info("GetRoomListRpc response", "player_id", p.Uid, "ret", resp.GetRet(), "room_count", len(resp.GetRooms()))
+	resp, err := client.GetRoomListRpc(ctx, &pb.GetRoomListRpcRequest{
+		Filter: filter,
+	})
+	if err != nil {
+		slog.Error("获取房间列表RPC调用失败: ", "address", addr, "error", err)
+		return nil
+	}
+	return resp
+}
 
-	if resp.Ret != pb.ErrorCode_OK {
-		slog.Error("获取房间列表失败，错误码: ", "error_code", resp.Ret)
+// mergeRoomLists 合并各实例返回的同一页房间，按统一的排序规则取前 page_size 个
+// 每个实例都返回了游标之后的前 page_size 个房间，因此合并后的前 page_size 个即为全局结果
+func mergeRoomLists(filter *pb.GetRoomListRequest, results []*pb.GetRoomListRpcResponse) *pb.GetRoomListResponse {
+	ret := pb.ErrorCode_SERVER_ERROR
+	hasMore := false
+	var rooms []*pb.Room
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		if result.Ret != pb.ErrorCode_OK {
+			// 参数错误（如游标非法）各实例结果一致，直接返回
+			if ret != pb.ErrorCode_OK {
+				ret = result.Ret
+			}
+			continue
+		}
+		ret = pb.ErrorCode_OK
+		rooms = append(rooms, result.Rooms...)
+		if result.NextCursor != "" {
+			hasMore = true
+		}
+	}
+	if ret != pb.ErrorCode_OK {
+		return &pb.GetRoomListResponse{Ret: ret}
 	}
 
-	// 返回房间列表给客户端
-	p.SendResponse(msg, mustMarshal(&pb.GetRoomListResponse{
-		Ret:   resp.Ret,
-		Rooms: resp.Rooms,
-	}))
+	fullest := filter.GetSort() == pb.RoomSortOrder_ROOM_SORT_FULLEST
+	keyOf := func(room *pb.Room) roomlist.Cursor {
+		return roomlist.NewCursor(fullest, room.CreateTime, room.CurrentPlayers, room.Id)
+	}
+	sort.Slice(rooms, func(i, j int) bool {
+		return keyOf(rooms[i]).Before(keyOf(rooms[j]))
+	})
+
+	pageSize := int(filter.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = roomlist.DefaultPageSize
+	}
+	if pageSize > roomlist.MaxPageSize {
+		pageSize = roomlist.MaxPageSize
+	}
+
+	nextCursor := ""
+	if len(rooms) > pageSize {
+		rooms = rooms[:pageSize]
+		hasMore = true
+	}
+	if hasMore && len(rooms) > 0 {
+		nextCursor = keyOf(rooms[len(rooms)-1]).Encode()
+	}
+
+	return &pb.GetRoomListResponse{
+		Ret:        pb.ErrorCode_OK,
+		Rooms:      rooms,
+		NextCursor: nextCursor,
+	}
 }
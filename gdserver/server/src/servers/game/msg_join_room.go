@@ -1,58 +1,71 @@
 package main
 
 import (
-	"context"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"common/redisutil"
+	"errors"
+	"fmt"
 	"google.golang.org/protobuf/proto"
-	"log"
 	"log/slog"
 	pb "proto"
 	"time"
 )
 
-func (p *Player) HandleJoinRoomRequest(msg *pb.Message) {
+func (p *Player) HandleJoinRoomRequest(msg *pb.Message) error {
 	var req pb.JoinRoomRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse JoinRoomRequest Request", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid JoinRoomRequest: %v", err)
 	}
 
-	conn, err := grpc.Dial(
-		"127.0.0.1:8693",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
+	return p.joinRoom(msg, req.RoomId, req.Password)
+}
+
+// HandleJoinRoomByCodeRequest 通过邀请码加入房间
+func (p *Player) HandleJoinRoomByCodeRequest(msg *pb.Message) error {
+	var req pb.JoinRoomByCodeRequest
+	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid JoinRoomByCodeRequest: %v", err)
+	}
+
+	roomID, err := GlobalRedis.GetRoomIDByInviteCode(req.InviteCode)
 	if err != nil {
-		log.Printf("连接BattleServer失败: %s, 错误: %v", "127.0.0.1:8693", err)
-		return
+		if errors.Is(err, redisutil.ErrKeyNotFound) {
+			// 邀请码不存在或已过期
+			return NewHandlerError(pb.ErrorCode_NOT_FOUND, "invite code %s not found", req.InviteCode)
+		}
+		return fmt.Errorf("resolve invite code %s: %w", req.InviteCode, err)
 	}
 
-	defer conn.Close()
+	slog.Info("Invite code resolved", "player_id", p.Uid, "invite_code", req.InviteCode, "room_id", roomID)
+	return p.joinRoom(msg, roomID, req.Password)
+}
 
-	client := pb.NewRoomRpcServiceClient(conn)
+// joinRoom 调用BattleServer加入房间，并以 JoinRoomResponse 回复 msg
+func (p *Player) joinRoom(msg *pb.Message, roomID string, password string) error {
+	client, err := battleClient(roomID)
+	if err != nil {
+		if errors.Is(err, redisutil.ErrKeyNotFound) {
+			return NewHandlerError(pb.ErrorCode_INVALID_ROOM, "room %s not found", roomID) // 房间不存在或已关闭
+		}
+		return fmt.Errorf("connect to BattleServer: %w", err)
+	}
 
 	playerInitData := &pb.PlayerInitData{
 		PlayerId:   p.Uid,
-		PlayerName: p.Name,
+		PlayerName: p.DisplayName(),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
 	defer cancel()
 
 	joinRoomRpc := &pb.JoinRoomRpcRequest{
-		RoomId: req.RoomId,
-		Player: playerInitData,
+		RoomId:   roomID,
+		Player:   playerInitData,
+		Password: password,
 	}
 
 	resp, err := client.JoinRoomRpc(ctx, joinRoomRpc)
 	if err != nil {
-		slog.Error("加入房间RPC调用失败: ", "error", err)
-		p.SendResponse(msg, mustMarshal(&pb.JoinRoomResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("JoinRoomRpc: %w", err)
 	}
 
 	// 修复：使用新的RoomDetail字段访问方式
@@ -65,8 +78,8 @@ func (p *Player) HandleJoinRoomRequest(msg *pb.Message) {
 		slog.Error("加入房间失败，错误码: ", "error_code", resp.Ret)
 	} else {
 		// 加入房间成功，设置当前房间ID
-		p.CurrentRoomID = roomId
-		slog.Info("玩家成功加入房间", "player_id", p.Uid, "room_id", p.CurrentRoomID)
+		p.setRoomID(roomId)
+		slog.Info("玩家成功加入房间", "player_id", p.Uid, "room_id", roomId)
 	}
 
 	// 返回响应
@@ -75,4 +88,5 @@ func (p *Player) HandleJoinRoomRequest(msg *pb.Message) {
 		Ret:         resp.Ret,
 		RoomDetail: resp.GetRoom(), // 直接使用RPC返回的RoomDetail
 	}))
+	return nil
 }
\ No newline at end of file
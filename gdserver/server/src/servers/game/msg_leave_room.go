@@ -1,77 +1,56 @@
 package main
 
 import (
-	"context"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"fmt"
 	"google.golang.org/protobuf/proto"
-	"log"
 	"log/slog"
 	pb "proto"
 	"time"
 )
 
-func (p *Player) HandleLeaveRoomRequest(msg *pb.Message) {
+func (p *Player) HandleLeaveRoomRequest(msg *pb.Message) error {
 	var req pb.LeaveRoomRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse LeaveRoomRequest Request", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid LeaveRoomRequest: %v", err)
 	}
 
 	slog.Info("HandleLeaveRoomRequest called", "player_id", p.Uid)
 
+	roomID := p.RoomID()
+
 	//暂时连接到固定的 BattleServer地址，后续通过redis做服务发现，获得一个空闲的 BattleServer地址
-	conn, err := grpc.Dial(
-		"127.0.0.1:8693",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
+	client, err := battleClient(roomID)
 	if err != nil {
-		log.Printf("连接BattleServer失败: %s, 错误: %v", "127.0.0.1:8693", err)
-		// 即使连接失败，也发送响应
-		p.SendResponse(msg, mustMarshal(&pb.LeaveRoomResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("connect to BattleServer: %w", err)
 	}
 
-	defer conn.Close()
-
-	client := pb.NewRoomRpcServiceClient(conn)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
 	defer cancel()
 
 	// 创建离开房间请求，传递房间ID和玩家ID
 	leaveRoomRpc := &pb.LeaveRoomRpcRequest{
-		RoomId:   p.CurrentRoomID, // 传递房间ID
+		RoomId:   roomID, // 传递房间ID
 		PlayerId: p.Uid,
 	}
 
-	slog.Info("Calling LeaveRoomRpc", "player_id", p.Uid, "room_id", p.CurrentRoomID)
+	slog.Info("Calling LeaveRoomRpc", "player_id", p.Uid, "room_id", roomID)
 
 	resp, err := client.LeaveRoomRpc(ctx, leaveRoomRpc)
 	if err != nil {
-		slog.Error("离开房间RPC调用失败: ", "error", err)
-		// 即使RPC失败，也发送响应
-		p.SendResponse(msg, mustMarshal(&pb.LeaveRoomResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("LeaveRoomRpc: %w", err)
 	}
 
 	if resp.Ret != pb.ErrorCode_OK {
 		slog.Error("离开房间失败，错误码: ", "error_code", resp.Ret)
 	} else {
 		// 离开房间成功，清空当前房间ID
-		oldRoomID := p.CurrentRoomID
-		p.CurrentRoomID = ""
-		slog.Info("离开房间成功", "player_id", p.Uid, "old_room_id", oldRoomID, "new_room_id", resp.RoomId)
+		p.clearRoomID(roomID)
+		slog.Info("离开房间成功", "player_id", p.Uid, "old_room_id", roomID, "new_room_id", resp.RoomId)
 	}
 
 	// 返回响应
 	p.SendResponse(msg, mustMarshal(&pb.LeaveRoomResponse{
 		Ret: resp.Ret,
 	}))
+	return nil
 }
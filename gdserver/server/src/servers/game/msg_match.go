@@ -1,54 +1,35 @@
 package main
 
 import (
-	"context"
-	"log"
+	"errors"
+	"fmt"
 	"log/slog"
 	pb "proto"
 	"time"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
 )
 
-func (p *Player) HandleMatchRequest(msg *pb.Message) {
+func (p *Player) HandleMatchRequest(msg *pb.Message) error {
 	var req pb.MatchRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse MatchRequest", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid MatchRequest: %v", err)
 	}
 
 	// 检查玩家是否在房间中
-	if p.CurrentRoomID != "" {
-		slog.Error("玩家已经在房间中", "player_id", p.Uid)
-		p.SendResponse(msg, mustMarshal(&pb.MatchResponse{
-			Ret: pb.ErrorCode_PLAYER_ALREADY_IN_ROOM,
-		}))
-		return
+	if roomID := p.RoomID(); roomID != "" {
+		return NewHandlerError(pb.ErrorCode_PLAYER_ALREADY_IN_ROOM, "player already in room %s", roomID)
 	}
 
 	slog.Info("处理玩家匹配请求", "player_id", p.Uid)
 
-	//创建grp client , 给matchserver 发送匹配请求
-	conn, err := grpc.Dial(
-		"127.0.0.1:50052",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
+	//获取共享的 grpc client , 给matchserver 发送匹配请求
+	client, err := matchClient()
 	if err != nil {
-		log.Printf("连接MatchServer失败: %s, 错误: %v", "127.0.0.1:50052", err)
-		p.SendResponse(msg, mustMarshal(&pb.MatchResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("connect to MatchServer: %w", err)
 	}
-	defer conn.Close()
 
-	client := pb.NewMatchRpcServiceClient(conn)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
 	defer cancel()
 
 	//发送grpc
@@ -56,18 +37,10 @@ func (p *Player) HandleMatchRequest(msg *pb.Message) {
 		PlayerId: p.Uid,
 	})
 	if err != nil {
-		slog.Error("StartMatch RPC failed", "error", err)
-		p.SendResponse(msg, mustMarshal(&pb.MatchResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("StartMatchRpc: %w", err)
 	}
 	if resp == nil {
-		slog.Error("StartMatch RPC returned nil response")
-		p.SendResponse(msg, mustMarshal(&pb.MatchResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return errors.New("StartMatchRpc returned nil response")
 	}
 
 	if resp.Ret != pb.ErrorCode_OK {
@@ -78,34 +51,23 @@ func (p *Player) HandleMatchRequest(msg *pb.Message) {
 	p.SendResponse(msg, mustMarshal(&pb.MatchResponse{
 		Ret: resp.Ret,
 	}))
+	return nil
 }
 
-func (p *Player) HandleCancelMatchRequest(msg *pb.Message) {
+func (p *Player) HandleCancelMatchRequest(msg *pb.Message) error {
 	var req pb.CancelMatchRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse CancelMatchRequest", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid CancelMatchRequest: %v", err)
 	}
 	slog.Info("处理玩家取消匹配请求", "player_id", p.Uid)
 
-	//创建grp client , 给matchserver 发送取消匹配请求
-	conn, err := grpc.Dial(
-		"127.0.0.1:50052",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
+	//获取共享的 grpc client , 给matchserver 发送取消匹配请求
+	client, err := matchClient()
 	if err != nil {
-		log.Printf("连接MatchServer失败: %s, 错误: %v", "127.0.0.1:50052", err)
-		p.SendResponse(msg, mustMarshal(&pb.CancelMatchResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("connect to MatchServer: %w", err)
 	}
-	defer conn.Close()
 
-	client := pb.NewMatchRpcServiceClient(conn)
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
 	defer cancel()
 
 	//发送grpc
@@ -113,18 +75,10 @@ func (p *Player) HandleCancelMatchRequest(msg *pb.Message) {
 		PlayerId: p.Uid,
 	})
 	if err != nil {
-		slog.Error("CancelMatch RPC failed", "error", err)
-		p.SendResponse(msg, mustMarshal(&pb.CancelMatchResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("CancelMatchRpc: %w", err)
 	}
 	if resp == nil {
-		slog.Error("CancelMatch RPC returned nil response")
-		p.SendResponse(msg, mustMarshal(&pb.CancelMatchResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return errors.New("CancelMatchRpc returned nil response")
 	}
 
 	if resp.Ret != pb.ErrorCode_OK {
@@ -135,4 +89,5 @@ func (p *Player) HandleCancelMatchRequest(msg *pb.Message) {
 	p.SendResponse(msg, mustMarshal(&pb.CancelMatchResponse{
 		Ret: resp.Ret,
 	}))
+	return nil
 }
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	pb "proto"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// HandleSendRoomInviteRequest 邀请在线好友加入自己所在的房间
+func (p *Player) HandleSendRoomInviteRequest(msg *pb.Message) error {
+	var req pb.SendRoomInviteRequest
+	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid SendRoomInviteRequest: %v", err)
+	}
+
+	// 检查玩家是否在房间中
+	roomID := p.RoomID()
+	if roomID == "" {
+		return NewHandlerError(pb.ErrorCode_INVALID_ROOM, "player not in any room")
+	}
+
+	slog.Info("处理房间邀请请求", "player_id", p.Uid, "room_id", roomID, "invitee", req.InviteeUid)
+
+	client, err := battleClient(roomID)
+	if err != nil {
+		return fmt.Errorf("connect to BattleServer: %w", err)
+	}
+
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
+	defer cancel()
+
+	resp, err := client.InviteToRoomRpc(ctx, &pb.InviteToRoomRpcRequest{
+		RoomId:      roomID,
+		InviterId:   p.Uid,
+		InviterName: p.DisplayName(),
+		InviteeId:   req.InviteeUid,
+	})
+	if err != nil {
+		return fmt.Errorf("InviteToRoomRpc: %w", err)
+	}
+
+	if resp.Ret != pb.ErrorCode_OK {
+		slog.Warn("房间邀请失败，错误码: ", "error_code", resp.Ret)
+	}
+
+	p.SendResponse(msg, mustMarshal(&pb.SendRoomInviteResponse{
+		Ret:        resp.Ret,
+		InviteCode: resp.InviteCode,
+	}))
+	return nil
+}
@@ -3,31 +3,78 @@ package main
 import (
 	"log/slog"
 	pb "proto"
+	"time"
 )
 
+// route 单个消息ID的处理函数和配置
+type route struct {
+	handler HandlerFunc
+	public  bool          // 认证前可以发送
+	timeout time.Duration // 处理时限（下游 RPC 的截止时间），0 表示 DefaultHandlerTimeout
+	class   HandlerClass  // 处理类别，见 pipeline.go
+}
+
+// RouteOption 注册处理函数时的配置
+type RouteOption func(*route)
+
+// Public 认证前也可以发送的消息（认证、心跳）
+func Public() RouteOption {
+	return func(r *route) { r.public = true }
+}
+
+// WithDeadline 单独设置处理时限
+func WithDeadline(timeout time.Duration) RouteOption {
+	return func(r *route) { r.timeout = timeout }
+}
+
 // 消息管理器
 type MessageManager struct {
-	player_handlers map[pb.MessageId]func(player *Player, msg *pb.Message)
+	player_handlers map[pb.MessageId]*route // handler 为包装中间件后的处理函数
+	middlewares     []Middleware
 	//room_handlers   map[pb.MessageId]func(room *Room, roomMsg *RoomMessage)
 }
 
 // 初始化消息管理器
 func NewMessageManager() *MessageManager {
 	return &MessageManager{
-		player_handlers: make(map[pb.MessageId]func(player *Player, msg *pb.Message)),
+		player_handlers: make(map[pb.MessageId]*route),
 		//room_handlers:   make(map[pb.MessageId]func(room *Room, roomMsg *RoomMessage)),
 	}
 }
 
-// 注册消息处理回调
-func (m *MessageManager) RegisterHandler(msgId pb.MessageId, handler func(player *Player, msg *pb.Message)) {
-	m.player_handlers[msgId] = handler
+// Use 添加作用于所有消息的中间件，先添加的在外层；只对之后注册的处理函数生效
+func (m *MessageManager) Use(middlewares ...Middleware) {
+	m.middlewares = append(m.middlewares, middlewares...)
 }
 
-// 处理消息
+// Register 注册返回错误的处理函数，默认需要认证
+func (m *MessageManager) Register(msgId pb.MessageId, handler HandlerFunc, opts ...RouteOption) {
+	r := &route{handler: handler}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.timeout == 0 {
+		r.timeout = DefaultHandlerTimeout
+	}
+
+	middlewares := append([]Middleware(nil), m.middlewares...)
+	if !r.public {
+		middlewares = append(middlewares, requireAuth)
+	}
+	middlewares = append(middlewares, withDeadline(r.timeout))
+	r.handler = Chain(r.handler, middlewares...)
+	m.player_handlers[msgId] = r
+}
+
+// 处理消息，ClassOrdered 的消息直接处理，其他类别交给工作池
+// 认证检查在分发前进行，工作池中的处理函数只会看到已认证的连接
 func (m *MessageManager) HandleMessage(player *Player, msg *pb.Message) {
-	if handler, ok := m.player_handlers[msg.GetId()]; ok {
-		handler(player, msg)
+	if r, ok := m.player_handlers[msg.GetId()]; ok {
+		if r.class == ClassOrdered || (!r.public && !player.Authenticated) {
+			r.handler(player, msg)
+			return
+		}
+		player.dispatch(r.class, r.handler, msg)
 	} else {
 		slog.Info("Message not registered", "msgId", msg.GetId())
 	}
@@ -38,22 +85,29 @@ var MsgHandler = NewMessageManager()
 
 // 注册所有消息回调
 func InitMessageHandlers() {
-	MsgHandler.RegisterHandler(pb.MessageId_AUTH_REQUEST, (*Player).HandleAuthRequest)
-	MsgHandler.RegisterHandler(pb.MessageId_DRAW_CARD_REQUEST, (*Player).HandleDrawCardRequest)
-	MsgHandler.RegisterHandler(pb.MessageId_GET_USER_INFO_REQUEST, (*Player).HandleGetUserInfoRequest)
+	MsgHandler.Use(traceMessage, logMessage, respondErrors, recoverPanic)
+
+	MsgHandler.Register(pb.MessageId_AUTH_REQUEST, (*Player).HandleAuthRequest, Public())
+	MsgHandler.Register(pb.MessageId_HEARTBEAT_REQUEST, (*Player).HandleHeartbeatRequest, Public())
+	MsgHandler.Register(pb.MessageId_PUSH_ACK, (*Player).HandlePushAck)
+	MsgHandler.Register(pb.MessageId_LOGOUT_REQUEST, (*Player).HandleLogoutRequest)
+	MsgHandler.Register(pb.MessageId_DRAW_CARD_REQUEST, (*Player).HandleDrawCardRequest, InClass(ClassProfile))
+	MsgHandler.Register(pb.MessageId_GET_USER_INFO_REQUEST, (*Player).HandleGetUserInfoRequest, InClass(ClassProfile))
 
-	MsgHandler.RegisterHandler(pb.MessageId_CREATE_ROOM_REQUEST, (*Player).HandleCreateRoomRequest)
-	MsgHandler.RegisterHandler(pb.MessageId_JOIN_ROOM_REQUEST, (*Player).HandleJoinRoomRequest)
-	MsgHandler.RegisterHandler(pb.MessageId_LEAVE_ROOM_REQUEST, (*Player).HandleLeaveRoomRequest)
-	MsgHandler.RegisterHandler(pb.MessageId_GET_READY_REQUEST, (*Player).HandleGetReadyRequest)
+	MsgHandler.Register(pb.MessageId_CREATE_ROOM_REQUEST, (*Player).HandleCreateRoomRequest)
+	MsgHandler.Register(pb.MessageId_JOIN_ROOM_REQUEST, (*Player).HandleJoinRoomRequest)
+	MsgHandler.Register(pb.MessageId_JOIN_ROOM_BY_CODE_REQUEST, (*Player).HandleJoinRoomByCodeRequest)
+	MsgHandler.Register(pb.MessageId_SEND_ROOM_INVITE_REQUEST, (*Player).HandleSendRoomInviteRequest)
+	MsgHandler.Register(pb.MessageId_LEAVE_ROOM_REQUEST, (*Player).HandleLeaveRoomRequest)
+	MsgHandler.Register(pb.MessageId_GET_READY_REQUEST, (*Player).HandleGetReadyRequest)
 
 	// 添加获取房间列表的处理
-	MsgHandler.RegisterHandler(pb.MessageId_GET_ROOM_LIST_REQUEST, (*Player).HandleGetRoomListRequest)
+	MsgHandler.Register(pb.MessageId_GET_ROOM_LIST_REQUEST, (*Player).HandleGetRoomListRequest, InClass(ClassLobby))
 
-	MsgHandler.RegisterHandler(pb.MessageId_GAME_ACTION_REQUEST, (*Player).HandlePlayerActionRequest)
+	MsgHandler.Register(pb.MessageId_GAME_ACTION_REQUEST, (*Player).HandlePlayerActionRequest)
 
-	MsgHandler.RegisterHandler(pb.MessageId_MATCH_REQUEST, (*Player).HandleMatchRequest)
-	MsgHandler.RegisterHandler(pb.MessageId_CANCEL_MATCH_REQUEST, (*Player).HandleCancelMatchRequest)
+	MsgHandler.Register(pb.MessageId_MATCH_REQUEST, (*Player).HandleMatchRequest, InClass(ClassMatch))
+	MsgHandler.Register(pb.MessageId_CANCEL_MATCH_REQUEST, (*Player).HandleCancelMatchRequest, InClass(ClassMatch))
 
 }
 
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"log/slog"
+	pb "proto"
+	"sync"
+)
+
+// HandlerClass 消息处理类别
+// 同一类别的消息按到达顺序依次处理，不同类别之间互不等待：
+// 房间操作等需要与连接状态严格有序的消息在玩家的消息处理协程中执行，
+// 其他类别在共享的工作池中执行，慢的下游 RPC（如匹配）不会拖慢出牌等房间操作
+type HandlerClass string
+
+const (
+	ClassOrdered HandlerClass = ""        // 认证、心跳、房间操作，在玩家的消息处理协程中执行
+	ClassProfile HandlerClass = "profile" // 用户信息、抽卡
+	ClassLobby   HandlerClass = "lobby"   // 房间列表
+	ClassMatch   HandlerClass = "match"   // 匹配、取消匹配
+)
+
+// InClass 设置处理类别，默认为 ClassOrdered
+// 非 ClassOrdered 的处理函数与房间操作并发执行，与其他类别共享的玩家状态要通过加锁的访问方法读写
+// （房间ID 见 RoomID，资料字段见 profileMu）
+func InClass(class HandlerClass) RouteOption {
+	return func(r *route) { r.class = class }
+}
+
+var (
+	// HandlerWorkers 工作池协程数，HandlerQueueSize 等待执行的任务数上限
+	HandlerWorkers   = loadIntFromEnv("GAME_HANDLER_WORKERS", 64)
+	HandlerQueueSize = loadIntFromEnv("GAME_HANDLER_QUEUE_SIZE", 1024)
+	// LanePendingLimit 单个玩家单个类别排队的消息数上限
+	LanePendingLimit = loadIntFromEnv("GAME_LANE_PENDING_LIMIT", 16)
+)
+
+// WorkerPool 固定数量协程的工作池，任务队列满时拒绝提交
+type WorkerPool struct {
+	tasks chan func()
+}
+
+// NewWorkerPool 创建并启动工作池
+func NewWorkerPool(workers, queueSize int) *WorkerPool {
+	pool := &WorkerPool{tasks: make(chan func(), queueSize)}
+	for i := 0; i < workers; i++ {
+		go pool.work()
+	}
+	return pool
+}
+
+func (wp *WorkerPool) work() {
+	for task := range wp.tasks {
+		task()
+	}
+}
+
+// TrySubmit 提交任务，队列已满时返回 false
+func (wp *WorkerPool) TrySubmit(task func()) bool {
+	select {
+	case wp.tasks <- task:
+		return true
+	default:
+		return false
+	}
+}
+
+// Pending 等待执行的任务数
+func (wp *WorkerPool) Pending() int {
+	return len(wp.tasks)
+}
+
+// GlobalWorkerPool 非 ClassOrdered 消息的工作池
+var GlobalWorkerPool = NewWorkerPool(HandlerWorkers, HandlerQueueSize)
+
+// handlerLane 单个玩家单个类别的消息队列，同一时刻最多一个工作协程在处理
+type handlerLane struct {
+	mu      sync.Mutex
+	pending []laneTask
+	running bool // 已提交到工作池或正在处理
+}
+
+type laneTask struct {
+	handler HandlerFunc
+	msg     *pb.Message
+}
+
+// lane 玩家指定类别的队列，只在消息处理协程中调用
+func (p *Player) lane(class HandlerClass) *handlerLane {
+	if p.lanes == nil {
+		p.lanes = make(map[HandlerClass]*handlerLane)
+	}
+	l, ok := p.lanes[class]
+	if !ok {
+		l = &handlerLane{}
+		p.lanes[class] = l
+	}
+	return l
+}
+
+// dispatch 把消息放入类别队列，由工作池按顺序处理
+// 队列或工作池已满时回复 SERVER_BUSY（背压），客户端稍后重试
+func (p *Player) dispatch(class HandlerClass, handler HandlerFunc, msg *pb.Message) {
+	l := p.lane(class)
+
+	l.mu.Lock()
+	if len(l.pending) >= LanePendingLimit {
+		l.mu.Unlock()
+		p.rejectBusy(class, msg, "lane full")
+		return
+	}
+	l.pending = append(l.pending, laneTask{handler: handler, msg: msg})
+	p.lanesWG.Add(1)
+	if l.running {
+		l.mu.Unlock()
+		return
+	}
+	l.running = true
+	l.mu.Unlock()
+
+	if !GlobalWorkerPool.TrySubmit(func() { l.drain(p) }) {
+		// 队列之前为空（running 为 false），只有刚加入的这一条
+		l.mu.Lock()
+		l.pending = nil
+		l.running = false
+		l.mu.Unlock()
+		p.lanesWG.Done()
+		p.rejectBusy(class, msg, "worker pool saturated")
+	}
+}
+
+// drain 依次处理队列中的消息直到队列为空，玩家已退出时丢弃剩余消息
+func (l *handlerLane) drain(p *Player) {
+	for {
+		l.mu.Lock()
+		if len(l.pending) == 0 {
+			l.running = false
+			l.mu.Unlock()
+			return
+		}
+		task := l.pending[0]
+		l.pending = l.pending[1:]
+		l.mu.Unlock()
+
+		if p.ctx.Err() == nil {
+			task.handler(p, task.msg)
+		}
+		p.lanesWG.Done()
+	}
+}
+
+func (p *Player) rejectBusy(class HandlerClass, msg *pb.Message, reason string) {
+	handlerRejected.WithLabelValues(msg.GetId().String()).Inc()
+	slog.Warn("Handler pipeline busy, rejecting message", "conn_uuid", p.ConnUUID, "player_id", p.Uid,
+		"msg_id", msg.GetId(), "class", class, "reason", reason)
+	p.sendErrorResponse(msg, pb.ErrorCode_SERVER_BUSY, "")
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	pb "proto"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// withWorkerPool 测试期间替换全局工作池和单类别排队上限
+func withWorkerPool(t *testing.T, workers, queueSize, laneLimit int) {
+	t.Helper()
+	pool, limit := GlobalWorkerPool, LanePendingLimit
+	GlobalWorkerPool = NewWorkerPool(workers, queueSize)
+	LanePendingLimit = laneLimit
+	t.Cleanup(func() {
+		GlobalWorkerPool, LanePendingLimit = pool, limit
+	})
+}
+
+// waitLanes 等待已分发的消息处理完
+func waitLanes(t *testing.T, p *Player) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		p.lanesWG.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("lanes did not drain")
+	}
+}
+
+// expectBusy 读取一条响应，检查是 SERVER_BUSY
+func expectBusy(t *testing.T, p *Player, serial int32) {
+	t.Helper()
+	select {
+	case rsp := <-p.SendChan:
+		var match pb.MatchResponse
+		if err := proto.Unmarshal(rsp.GetData(), &match); err != nil {
+			t.Fatalf("unmarshal busy response: %v", err)
+		}
+		if rsp.GetId() != pb.MessageId_MATCH_RESPONSE || rsp.GetMsgSerialNo() != serial || match.Ret != pb.ErrorCode_SERVER_BUSY {
+			t.Fatalf("response = id %v serial %d ret %v, want MATCH_RESPONSE serial %d SERVER_BUSY",
+				rsp.GetId(), rsp.GetMsgSerialNo(), match.Ret, serial)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no SERVER_BUSY response for serial %d", serial)
+	}
+}
+
+func TestLaneKeepsOrderWithinClass(t *testing.T) {
+	withWorkerPool(t, 8, 64, 64)
+	p := newTestPlayer()
+
+	var (
+		mu      sync.Mutex
+		order   []int32
+		running atomic.Int32
+		maxRun  atomic.Int32
+	)
+	handler := func(_ *Player, msg *pb.Message) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := maxRun.Load()
+			if n <= old || maxRun.CompareAndSwap(old, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		order = append(order, msg.GetMsgSerialNo())
+		mu.Unlock()
+		return nil
+	}
+
+	const count = 20
+	for i := int32(0); i < count; i++ {
+		p.dispatch(ClassMatch, handler, testMsg(pb.MessageId_MATCH_REQUEST, i))
+	}
+	waitLanes(t, p)
+
+	if len(order) != count {
+		t.Fatalf("handled %d messages, want %d", len(order), count)
+	}
+	for i, serial := range order {
+		if serial != int32(i) {
+			t.Fatalf("order = %v, want 0..%d", order, count-1)
+		}
+	}
+	if got := maxRun.Load(); got != 1 {
+		t.Fatalf("max concurrent handlers in one class = %d, want 1", got)
+	}
+}
+
+func TestLaneClassesRunConcurrently(t *testing.T) {
+	withWorkerPool(t, 4, 16, 16)
+	p := newTestPlayer()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	p.dispatch(ClassMatch, func(*Player, *pb.Message) error {
+		close(started)
+		<-release
+		return nil
+	}, testMsg(pb.MessageId_MATCH_REQUEST, 1))
+	<-started
+
+	// 匹配类别被阻塞时，资料类别仍然可以处理
+	profileDone := make(chan struct{})
+	p.dispatch(ClassProfile, func(*Player, *pb.Message) error {
+		close(profileDone)
+		return nil
+	}, testMsg(pb.MessageId_GET_USER_INFO_REQUEST, 2))
+
+	select {
+	case <-profileDone:
+	case <-time.After(time.Second):
+		t.Fatal("profile lane blocked behind match lane")
+	}
+	close(release)
+	waitLanes(t, p)
+}
+
+func TestLaneBackpressure(t *testing.T) {
+	type step struct {
+		class  HandlerClass
+		serial int32
+	}
+	tests := []struct {
+		name      string
+		workers   int
+		queueSize int
+		laneLimit int
+		steps     []step // 第一条消息阻塞工作协程，其余消息在它开始处理后分发
+		busy      []int32
+	}{
+		{
+			// 单个类别排队达到上限后拒绝
+			name: "lane full", workers: 1, queueSize: 4, laneLimit: 2,
+			steps: []step{{ClassMatch, 1}, {ClassMatch, 2}, {ClassMatch, 3}, {ClassMatch, 4}},
+			busy:  []int32{4},
+		},
+		{
+			// 工作池协程都在忙且等待队列已满时拒绝
+			name: "pool saturated", workers: 1, queueSize: 1, laneLimit: 16,
+			steps: []step{{ClassProfile, 1}, {ClassMatch, 2}, {ClassLobby, 3}},
+			busy:  []int32{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withWorkerPool(t, tt.workers, tt.queueSize, tt.laneLimit)
+			p := newTestPlayer()
+
+			release := make(chan struct{})
+			started := make(chan struct{})
+			var handled atomic.Int32
+			block := func(*Player, *pb.Message) error {
+				close(started)
+				<-release
+				handled.Add(1)
+				return nil
+			}
+			noop := func(*Player, *pb.Message) error {
+				handled.Add(1)
+				return nil
+			}
+
+			for i, st := range tt.steps {
+				handler := noop
+				if i == 0 {
+					handler = block
+				}
+				p.dispatch(st.class, handler, testMsg(pb.MessageId_MATCH_REQUEST, st.serial))
+				if i == 0 {
+					<-started
+				}
+			}
+			for _, serial := range tt.busy {
+				expectBusy(t, p, serial)
+			}
+
+			close(release)
+			waitLanes(t, p)
+			if want := int32(len(tt.steps) - len(tt.busy)); handled.Load() != want {
+				t.Fatalf("handled %d messages, want %d", handled.Load(), want)
+			}
+			if len(p.SendChan) != 0 {
+				t.Fatalf("unexpected extra responses: %d", len(p.SendChan))
+			}
+		})
+	}
+}
+
+func TestLaneDropsPendingAfterShutdown(t *testing.T) {
+	withWorkerPool(t, 2, 16, 16)
+	p := newTestPlayer()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var handled atomic.Int32
+	p.dispatch(ClassMatch, func(*Player, *pb.Message) error {
+		close(started)
+		<-release
+		handled.Add(1)
+		return nil
+	}, testMsg(pb.MessageId_MATCH_REQUEST, 1))
+	<-started
+	for i := int32(2); i <= 5; i++ {
+		p.dispatch(ClassMatch, func(*Player, *pb.Message) error {
+			handled.Add(1)
+			return nil
+		}, testMsg(pb.MessageId_MATCH_REQUEST, i))
+	}
+
+	// 玩家退出：正在处理的消息完成，排队的消息被丢弃，lanesWG 仍然归零
+	p.cancelFunc()
+	close(release)
+	waitLanes(t, p)
+	if got := handled.Load(); got != 1 {
+		t.Fatalf("handled %d messages after shutdown, want 1", got)
+	}
+
+	l := p.lane(ClassMatch)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running || len(l.pending) != 0 {
+		t.Fatalf("lane not reset after drain: running=%v pending=%d", l.running, len(l.pending))
+	}
+}
@@ -2,18 +2,18 @@ package main
 
 import (
 	"common/redisutil"
+	"common/session"
 	"context"
-	"encoding/binary"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "proto"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -36,21 +36,33 @@ type Player struct {
 	NotiChan   chan *pb.Message // 给玩家发送通知的管道
 	ctx        context.Context
 	cancelFunc context.CancelFunc
+	msgCtxs    sync.Map                      // *pb.Message -> 正在处理的客户端消息的上下文，见 requestContext
+	push       atomic.Pointer[pushSession]   // 推送会话，认证后绑定，见 push.go
+	limiter    *rateLimiter                  // 消息限流，只在读协程中使用
+	replaced   atomic.Bool                   // 已被同一账号的新连接顶替，退出时不清理房间和匹配状态
+	closing    atomic.Bool                   // 已安排关闭连接（被踢下线或登出），见 session.go
+	lanes      map[HandlerClass]*handlerLane // 非 ClassOrdered 消息的队列，见 pipeline.go
+	lanesWG    sync.WaitGroup                // 已放入队列、尚未处理完的消息
 
 	// 认证相关字段
 	SessionID     string    // LoginServer 返回的 session_id
 	SessionExpiry time.Time // session 过期时间
 	Authenticated bool      // 是否已认证
 
+	// 房间信息，处理消息的协程和 gRPC 通知协程都会修改，通过 RoomID/setRoomID 访问
+	roomID string     // 当前所在房间ID
+	roomMu sync.Mutex // 保护 roomID
+
+	// 资料信息（基础信息、抽卡、背包），认证、资料类消息和抽卡可能在不同协程中进行，
+	// 读写都要持有 profileMu，其他协程读取昵称使用 DisplayName
+	profileMu sync.RWMutex
+
 	// 基础信息
 	Name    string
 	Exp     int64
 	Gold    int64
 	Diamond int64
 
-	// 房间信息
-	CurrentRoomID string // 当前所在房间ID
-
 	// 抽卡信息
 	DrawCardInfo *DrawCardInfo
 
@@ -92,11 +104,47 @@ func NewPlayer(connUUID string, conn Connection) *Player {
 		NotiChan:     make(chan *pb.Message, 1000),
 		ctx:          ctx,
 		cancelFunc:   cancel,
+		limiter:      newRateLimiter(),
 		Backpack:     &pb.BackpackInfo{},
 		DrawCardInfo: &DrawCardInfo{},
 	}
 }
 
+// RoomID 返回玩家当前所在房间ID，不在房间中时为空
+func (p *Player) RoomID() string {
+	p.roomMu.Lock()
+	defer p.roomMu.Unlock()
+	return p.roomID
+}
+
+// setRoomID 记录玩家所在房间，空字符串表示已离开房间
+func (p *Player) setRoomID(roomID string) {
+	p.roomMu.Lock()
+	p.roomID = roomID
+	p.roomMu.Unlock()
+}
+
+// adoptRoomID 玩家不在房间中时记录 roomID，已在房间中则保持不变
+func (p *Player) adoptRoomID(roomID string) {
+	p.roomMu.Lock()
+	if p.roomID == "" {
+		p.roomID = roomID
+	}
+	p.roomMu.Unlock()
+}
+
+// clearRoomID 玩家仍在 roomID 房间中时清空房间ID，返回是否清空
+// 离开房间期间玩家可能已进入新房间（如匹配成功通知），此时不能清空
+func (p *Player) clearRoomID(roomID string) bool {
+	p.roomMu.Lock()
+	defer p.roomMu.Unlock()
+	if roomID == "" || p.roomID != roomID {
+		return false
+	}
+	p.roomID = ""
+	return true
+}
+
 // GetVIPLevel 获取VIP等级
 func (p *Player) GetVIPLevel() int {
 	return 1
@@ -104,6 +152,8 @@ func (p *Player) GetVIPLevel() int {
 
 // GetDrawCount 获取抽卡次数
 func (p *Player) GetDrawCount() int32 {
+	p.profileMu.RLock()
+	defer p.profileMu.RUnlock()
 	if p.DrawCardInfo == nil {
 		return 0
 	}
@@ -117,6 +167,8 @@ func (p *Player) GetPlayerID() uint64 {
 
 // SetDrawCount 设置抽卡次数
 func (p *Player) SetDrawCount(count int32) {
+	p.profileMu.Lock()
+	defer p.profileMu.Unlock()
 	if p.DrawCardInfo == nil {
 		p.DrawCardInfo = &DrawCardInfo{}
 	}
@@ -126,6 +178,7 @@ func (p *Player) SetDrawCount(count int32) {
 // SaveDrawCardResults 保存抽卡结果
 func (p *Player) SaveDrawCardResults(cards []*pb.Card) {
 	drawCardCount := len(cards)
+	p.profileMu.Lock()
 	p.Backpack.Cards = append(p.Backpack.Cards, cards...)
 	p.BackpackToJsonString()
 
@@ -135,6 +188,7 @@ func (p *Player) SaveDrawCardResults(cards []*pb.Card) {
 		"last_draw_card_time": time.Now().Unix(),
 		"bag":                 p.BackpackJSON,
 	}
+	p.profileMu.Unlock()
 
 	if err := GlobalRedis.HMSet(fmt.Sprintf("user:%d", p.Uid), fields); err != nil {
 		slog.Error("Failed to save draw card results to Redis", "error", err)
@@ -144,21 +198,34 @@ func (p *Player) SaveDrawCardResults(cards []*pb.Card) {
 	slog.Info("Saved draw card results to Redis", "uid", p.Uid, "cards", cards)
 }
 
+// DisplayName 玩家昵称，可在任意消息处理协程中调用
+func (p *Player) DisplayName() string {
+	p.profileMu.RLock()
+	defer p.profileMu.RUnlock()
+	return p.Name
+}
+
 // Run 启动玩家逻辑协程
 func (p *Player) Run() {
 	var wg sync.WaitGroup
 	wg.Add(3) // 有三个goroutine需要等待
 
 	defer func() {
-		// 清理玩家退出时的资源
-		// 1. 先清理battle房间
-		p.cleanupBattleRoom()
+		// 等待工作池中的消息处理完（玩家已退出时剩余消息被丢弃），避免与下面的清理并发
+		p.lanesWG.Wait()
+
+		// 清理玩家退出时的资源，被新连接顶替时房间和匹配状态已转移给新连接
+		if !p.replaced.Load() {
+			// 1. 先清理battle房间
+			p.cleanupBattleRoom()
 
-		// 2. 清理匹配队列
-		p.cleanupMatchQueue()
+			// 2. 清理匹配队列
+			p.cleanupMatchQueue()
+		}
 
-		// 3. 从全局管理器中移除玩家
+		// 3. 从全局管理器中移除玩家，推送会话保留等待重连
 		GlobalManager.DeletePlayer(p.ConnUUID)
+		GlobalPushSessions.Detach(p)
 
 		// 4. 取消上下文以停止所有goroutine
 		p.cancelFunc()
@@ -167,102 +234,81 @@ func (p *Player) Run() {
 		close(p.RecvChan)
 		//close(p.SendChan) //为了避免grpc 收到消息,拿到layer后的瞬间,这里关闭了发送管道,导致的panic ,这里就直接不关闭了,等待垃圾回收
 
-		// 6. 关闭连接
-		defer p.Conn.Close()
+		// 连接已在发送协程退出时关闭
 
 		slog.Info("Player exited and cleaned up", "conn_uuid", p.ConnUUID, "uid", p.Uid)
 	}()
 
-	// 处理接收消息的goroutine
+	// 处理接收消息的goroutine，连接负责分帧和解码，这里只处理完整的消息
 	go func() {
 		defer wg.Done()
-		buffer := make([]byte, 0, 4096) // 初始缓冲区
 		for {
 			select {
 			case <-p.ctx.Done():
 				slog.Info("Context done, exiting read loop", "conn_uuid", p.ConnUUID)
 				return
 			default:
-				slog.Info("Waiting to read from connection...", "conn_uuid", p.ConnUUID)
+			}
 
-				// Temporary buffer
-				tempBuf := make([]byte, 1024)
-				n, err := p.Conn.Read(tempBuf)
-				if err != nil {
+			p.extendReadDeadline()
+			msg, err := p.Conn.ReadMessage()
+			if err != nil {
+				if p.ctx.Err() != nil {
+					return // 连接已由发送协程关闭
+				}
+				switch {
+				case errors.Is(err, ErrMalformedMessage):
+					// 帧已完整读出，丢弃后继续读取
+					slog.Error("Failed to unmarshal message", "conn_uuid", p.ConnUUID, "error", err)
+					continue
+				case errors.Is(err, ErrFrameTooLarge):
+					slog.Error("Invalid frame, closing connection", "conn_uuid", p.ConnUUID, "uid", p.Uid, "error", err)
+				case isReadTimeout(err):
+					idleDisconnects.Inc()
+					slog.Info("Connection idle timeout", "conn_uuid", p.ConnUUID, "uid", p.Uid, "timeout", ReadTimeout)
+				default:
 					slog.Info("Connection closed", "conn_uuid", p.ConnUUID, "reason", err)
-					p.cancelFunc() // 取消上下文以停止所有goroutine
-					return
 				}
+				p.cancelFunc() // 取消上下文以停止所有goroutine
+				return
+			}
 
-				// 将读取的数据追加到缓冲区
-				buffer = append(buffer, tempBuf[:n]...)
-
-				// 处理缓冲区中的数据
-				for {
-					slog.Debug("Buffer content", "length", len(buffer), "buffer", buffer)
-					// Check if there is enough data to read the packet length
-					if len(buffer) < 4 {
-						slog.Debug("Not enough data to read the packet length, breaking out of inner loop")
-						break
-					}
-
-					// 读取包长度
-					length := int(binary.LittleEndian.Uint32(buffer[:4]))
-
-					// 验证包长度是否合理
-					if length <= 0 || length > 1024*1024 { // 限制最大包大小为1MB
-						slog.Error("Invalid packet length", "length", length, "buffer_len", len(buffer))
-						p.cancelFunc() // 关闭连接
-						return
-					}
-
-					// 检查是否有足够的数据读取完整包
-					if len(buffer) < 4+length {
-						slog.Debug("Not enough data for complete packet", "need", 4+length, "have", len(buffer))
-						break // 没有足够的数据用于完整包
-					}
-
-					// 读取完整包
-					messageBuf := buffer[4 : 4+length]
-					buffer = buffer[4+length:] // 移除已处理的数据
-
-					var parsedMsg pb.Message
-					if err := proto.Unmarshal(messageBuf, &parsedMsg); err != nil {
-						slog.Error("Failed to unmarshal message", "error", err)
-						continue
-					}
-
-					// 尝试将消息发送到RecvChan
-					select {
-					case p.RecvChan <- &parsedMsg:
-						// 成功入队
-					default:
-						// 如果通道已满，则丢弃消息
-						slog.Error("RecvChan full, dropping message", "message", parsedMsg)
-					}
-				}
+			// 限流在入队前检查，刷消息的客户端不会占满接收队列
+			if allowed, keep := p.checkRateLimit(msg); !keep {
+				p.cancelFunc()
+				return
+			} else if !allowed {
+				continue
+			}
+
+			// 尝试将消息发送到RecvChan
+			select {
+			case p.RecvChan <- msg:
+				// 成功入队
+			default:
+				// 如果通道已满，则丢弃消息并回复服务繁忙
+				recvChanDropped.Inc()
+				slog.Error("RecvChan full, dropping message", "conn_uuid", p.ConnUUID, "msg_id", msg.GetId())
+				p.trySendErrorResponse(msg, pb.ErrorCode_SERVER_BUSY)
 			}
 		}
 	}()
 
-	// 处理发送消息的协程
+	// 处理发送消息的协程，退出时关闭连接，阻塞在 ReadMessage 的读协程随之返回
 	go func() {
 		defer wg.Done()
+		defer p.Conn.Close()
 		for {
 			select {
 			case rspMsg := <-p.SendChan:
-				data, err := proto.Marshal(rspMsg)
-				if err != nil {
-					slog.Error("Failed to marshal response", "error", err)
-					continue
+				if rspMsg == nil {
+					// 关闭标记，之前入队的消息已全部发出，见 kick
+					p.cancelFunc()
+					return
 				}
-
-				length := make([]byte, 4)
-				binary.LittleEndian.PutUint32(length, uint32(len(data)))
-
-				slog.Info("In Send chan coroutine, Sending response", "messageLength", len(data), "message", rspMsg)
-				packet := append(length, data...)
-				if _, err := p.Conn.Write(packet); err != nil {
+				slog.Info("In Send chan coroutine, Sending response", "msg_id", rspMsg.GetId(), "message", rspMsg)
+				p.Conn.SetWriteDeadline(time.Now().Add(WriteTimeout))
+				if err := p.Conn.WriteMessage(rspMsg); err != nil {
 					p.cancelFunc() // 取消上下文以停止所有goroutine
 					slog.Error("Failed to write response", "error", err)
 					return
@@ -282,15 +328,9 @@ func (p *Player) Run() {
 			case <-p.ctx.Done():
 				return
 			case msg := <-p.RecvChan:
-				slog.Info("Received message", "message_id", msg.GetId(), "player_id", p.Uid, "message", msg)
-				if msg.GetId() != pb.MessageId_AUTH_REQUEST && !p.Authenticated {
-					slog.Warn("Unauthenticated player attempted to send message",
-						"conn_uuid", p.ConnUUID, "msg_id", msg.GetId())
-					return
-				}
-				slog.Info("Handling message", "message_id", msg.GetId(), "player_id", p.Uid)
+				slog.Debug("Received message", "message_id", msg.GetId(), "player_id", p.Uid, "message", msg)
+				// 认证检查、异常恢复、日志和指标见 middleware.go
 				MsgHandler.HandleMessage(p, msg)
-				slog.Info("Finished handling message", "message_id", msg.GetId(), "player_id", p.Uid)
 			}
 		}
 	}()
@@ -308,17 +348,29 @@ func (p *Player) SendMessage(msg *pb.Message) {
 	p.SendChan <- msg
 }
 
+// TrySendMessage 非阻塞发送，发送队列已满时返回 false
+func (p *Player) TrySendMessage(msg *pb.Message) bool {
+	select {
+	case p.SendChan <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
 // SendResponse 发送响应
 func (p *Player) SendResponse(srcMsg *pb.Message, responseData []byte) {
-	// 响应
-	response := &pb.Message{
+	p.SendMessage(newResponse(srcMsg, responseData))
+}
+
+// newResponse 构造请求对应的响应消息
+func newResponse(srcMsg *pb.Message, responseData []byte) *pb.Message {
+	return &pb.Message{
 		Id:          srcMsg.GetId() + 1,      // 响应ID是请求ID + 1
 		MsgSerialNo: srcMsg.GetMsgSerialNo(), // 使用相同的消息序列号
 		ClientId:    srcMsg.GetClientId(),    // 使用相同的客户端ID
 		Data:        responseData,
 	}
-
-	p.SendMessage(response)
 }
 
 // cleanupBattleRoom 清理玩家所在的battle房间
@@ -327,28 +379,26 @@ func (p *Player) cleanupBattleRoom() {
 		return // 未认证的玩家不需要清理
 	}
 
-	slog.Info("Cleaning up battle room for disconnected player", "player_id", p.Uid)
+	roomID := p.RoomID()
+	if roomID == "" {
+		return // 不在房间中，无需清理
+	}
+
+	slog.Info("Cleaning up battle room for disconnected player", "player_id", p.Uid, "room_id", roomID)
 
-	// 连接到BattleServer清理房间
-	conn, err := grpc.Dial(
-		"127.0.0.1:8693",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
+	// 连接到房间所在的BattleServer清理房间
+	client, err := battleClient(roomID)
 	if err != nil {
 		slog.Error("Failed to connect to BattleServer for cleanup", "player_id", p.Uid, "error", err)
 		return
 	}
-	defer conn.Close()
 
-	client := pb.NewRoomRpcServiceClient(conn)
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := p.requestContext(nil, 3*time.Second)
 	defer cancel()
 
 	// 发送离开房间请求
 	leaveRoomRpc := &pb.LeaveRoomRpcRequest{
-		RoomId:   p.CurrentRoomID, // 传递房间ID
+		RoomId:   roomID, // 传递房间ID
 		PlayerId: p.Uid,
 	}
 
@@ -361,7 +411,7 @@ func (p *Player) cleanupBattleRoom() {
 	if resp.Ret == pb.ErrorCode_OK {
 		slog.Info("Successfully cleaned up battle room", "player_id", p.Uid, "room_id", resp.RoomId)
 		// 清理成功后清空房间ID
-		p.CurrentRoomID = ""
+		p.clearRoomID(roomID)
 	} else {
 		slog.Warn("Battle room cleanup returned error", "player_id", p.Uid, "error_code", resp.Ret)
 	}
@@ -376,20 +426,13 @@ func (p *Player) cleanupMatchQueue() {
 	slog.Info("Cleaning up match queue for disconnected player", "player_id", p.Uid)
 
 	// 连接到MatchServer清理匹配队列
-	conn, err := grpc.Dial(
-		"127.0.0.1:50052",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithTimeout(2*time.Second),
-	)
+	client, err := matchClient()
 	if err != nil {
 		slog.Error("Failed to connect to MatchServer for cleanup", "player_id", p.Uid, "error", err)
 		return
 	}
-	defer conn.Close()
 
-	client := pb.NewMatchRpcServiceClient(conn)
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := p.requestContext(nil, 3*time.Second)
 	defer cancel()
 
 	// 发送取消匹配请求
@@ -411,32 +454,35 @@ func (p *Player) cleanupMatchQueue() {
 }
 
 // HandleAuthRequest 处理认证请求（统一流程:游客和正常用户都有token）
-func (p *Player) HandleAuthRequest(msg *pb.Message) {
+func (p *Player) HandleAuthRequest(msg *pb.Message) error {
 	var req pb.AuthRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse Auth Request", "error", err)
-		p.sendAuthErrorResponse(msg, pb.ErrorCode_INVALID_PARAM, "Invalid request format")
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "Invalid request format")
+	}
+
+	// 校验签名、时间戳和随机数（防重放）
+	if ret, reason := GlobalAuthVerifier.Verify(&req); ret != pb.ErrorCode_OK {
+		authSignatureFailures.WithLabelValues(ret.String()).Inc()
+		logSecurityEvent("Auth request rejected", "conn_uuid", p.ConnUUID, "remote_addr", p.Conn.RemoteAddr().String(),
+			"app_id", req.GetAppId(), "device_id", req.GetDeviceId(), "nonce", req.GetNonce(), "timestamp", req.GetTimestamp(),
+			"ret", ret.String(), "reason", reason)
+		return NewHandlerError(ret, "%s", reason)
 	}
 
 	// 统一验证token（游客和正常用户都必须有token）
 	if req.GetToken() == "" {
-		p.sendAuthErrorResponse(msg, pb.ErrorCode_INVALID_PARAM, "Token is required")
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "Token is required")
 	}
 
 	// 验证session/token
 	isValid, sessionData, err := validateSession(req.GetToken())
 	if err != nil {
-		slog.Error("Session validation error", "error", err)
-		p.sendAuthErrorResponse(msg, pb.ErrorCode_SERVER_ERROR, "Internal server error")
-		return
+		return fmt.Errorf("validate session: %w", err)
 	}
 
 	if !isValid {
 		slog.Info("Invalid session/token", "token", req.GetToken())
-		p.sendAuthErrorResponse(msg, pb.ErrorCode_AUTH_FAILED, "Invalid token or session expired")
-		return
+		return NewHandlerError(pb.ErrorCode_AUTH_FAILED, "Invalid token or session expired")
 	}
 
 	// 根据is_guest字段或者session中的openid判断是否为游客
@@ -449,63 +495,98 @@ func (p *Player) HandleAuthRequest(msg *pb.Message) {
 	gameUserData, gameUid, err = findOrCreateUserByOpenID(sessionData.OpenID, sessionData.Username)
 
 	if err != nil {
-		p.sendAuthErrorResponse(msg, pb.ErrorCode_SERVER_ERROR, "Failed to load user data")
-		return
+		return fmt.Errorf("find or create user: %w", err)
 	}
 
 	// 设置玩家信息
 	p.Uid = gameUid
 	p.SessionID = req.GetToken()
+	p.SessionExpiry = time.Unix(sessionData.ExpiresAt, 0)
 	p.Authenticated = true
-	p.Name = gameUserData.nickname
 	p.OpenId = sessionData.OpenID
 
 	// 从Redis加载用户完整信息
 	userData, err := loadUserDataFromRedis(p.Uid)
 	if err != nil {
-		p.sendAuthErrorResponse(msg, pb.ErrorCode_SERVER_ERROR, "Failed to load user data")
-		return
+		return fmt.Errorf("load user data: %w", err)
 	}
 
 	// 设置玩家属性
+	p.profileMu.Lock()
+	p.Name = gameUserData.nickname
 	p.Exp = userData.exp
 	p.Gold = userData.gold
 	p.Diamond = userData.diamond
-
-	// 加入到manager里面
-	GlobalManager.OnPlayerUinSet(p.ConnUUID)
+	p.profileMu.Unlock()
 
 	slog.Info("User authenticated", "uid", gameUid, "openid", sessionData.OpenID, "is_guest", isGuest)
 
-	// 返回认证成功响应
-	p.sendAuthSuccessResponse(msg, userData, isGuest)
+	// 绑定推送会话并返回认证成功响应，断线期间未确认的推送紧跟在响应之后补发
+	GlobalPushSessions.Attach(p, req.GetPushSessionId(), req.GetLastPushSeq(), func(session *pushSession, seq uint64, resync bool) {
+		p.sendAuthSuccessResponse(msg, userData, isGuest, session.id, seq, resync)
+	})
+
+	// 加入到manager里面，之后才会收到推送
+	if previous := GlobalManager.OnPlayerUinSet(p.ConnUUID); previous != nil {
+		p.takeOver(previous, req.GetDeviceType())
+	}
+
+	// 如果玩家仍在房间中（重连或战斗服重启后），重新同步房间完整状态
+	p.resyncRoomState(msg)
+	return nil
 }
 
-// 辅助函数：发送认证错误响应
-func (p *Player) sendAuthErrorResponse(srcMsg *pb.Message, errorCode pb.ErrorCode, errorMsg string) {
-	response := &pb.AuthResponse{
-		Ret:      errorCode,
-		Uid:      p.Uid,
-		ErrorMsg: errorMsg,
+// resyncRoomState 请求BattleServer推送玩家所在房间的完整状态
+// 新连接不知道玩家在哪个实例的房间里，依次询问所有战斗服
+func (p *Player) resyncRoomState(msg *pb.Message) {
+	for _, addr := range battleServerAddrs() {
+		roomID, ok := p.resyncRoomStateFrom(msg, addr)
+		if ok {
+			p.setRoomID(roomID)
+			slog.Info("Room state resynced", "player_id", p.Uid, "room_id", roomID, "battle_server", addr)
+			return
+		}
 	}
-	p.SendResponse(srcMsg, mustMarshal(response))
+}
+
+func (p *Player) resyncRoomStateFrom(msg *pb.Message, addr string) (string, bool) {
+	client, err := battleClientAt(addr)
+	if err != nil {
+		slog.Error("Failed to connect to BattleServer for resync", "player_id", p.Uid, "address", addr, "error", err)
+		return "", false
+	}
+
+	ctx, cancel := p.requestContext(msg, 3*time.Second)
+	defer cancel()
+
+	resp, err := client.ResyncRoomRpc(ctx, &pb.ResyncRoomRpcRequest{PlayerId: p.Uid})
+	if err != nil {
+		slog.Error("ResyncRoomRpc failed", "player_id", p.Uid, "address", addr, "error", err)
+		return "", false
+	}
+	return resp.RoomId, resp.Ret == pb.ErrorCode_OK
 }
 
 // 辅助函数：发送认证成功响应
-func (p *Player) sendAuthSuccessResponse(srcMsg *pb.Message, userData *UserData, isGuest bool) {
+func (p *Player) sendAuthSuccessResponse(srcMsg *pb.Message, userData *UserData, isGuest bool, pushSessionID string, pushSeq uint64, pushResync bool) {
+	p.profileMu.RLock()
 	response := &pb.AuthResponse{
 		Ret:           pb.ErrorCode_OK,
 		Uid:           p.Uid,
 		ConnId:        p.ConnUUID,
 		ServerTime:    time.Now().Format(time.RFC3339),
-		SessionExpiry: time.Now().Add(24 * time.Hour).Unix(),
+		SessionExpiry: p.SessionExpiry.Unix(),
 		Nickname:      p.Name,
 		Level:         calculateLevel(p.Exp),
 		Exp:           p.Exp,
 		Gold:          p.Gold,
 		Diamond:       p.Diamond,
 		IsGuest:       isGuest, // TODO: 等protobuf重新生成后开启
+		PushSessionId: pushSessionID,
+		PushSeq:       pushSeq,
+		PushResync:    pushResync,
 	}
+	p.profileMu.RUnlock()
 	p.SendResponse(srcMsg, mustMarshal(response))
 }
 
@@ -531,7 +612,7 @@ func mustMarshal(pb proto.Message) []byte {
 func validateSession(token string) (bool, *SessionData, error) {
 	// 从Redis中获取session信息
 	var sessionData SessionData
-	err := GlobalRedis.GetJSON("session:"+token, &sessionData)
+	err := GlobalRedis.GetJSON(session.Key(token), &sessionData)
 	if err != nil {
 		if err == redisutil.ErrKeyNotFound {
 			return false, nil, nil
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	pb "proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// 可靠推送
+// 服务器主动发给客户端的通知（MsgSerialNo 为 -1）在推送会话内按 pushSeq 连续编号，
+// 未被客户端确认（PUSH_ACK 或心跳中的 ack_push_seq）的推送保留在会话中。
+// 断线后会话保留 PushSessionTTL，客户端重连时在 AuthRequest 中带上会话ID和已确认的序号，
+// 服务器在认证响应之后按顺序补发缺失的推送；会话已过期或缺失部分已被丢弃时认证响应中 push_resync 为 true，
+// 客户端需要重新拉取完整状态（房间状态由服务器在认证后主动推送）。
+
+var (
+	// PushBufferSize 每个推送会话最多保留的未确认推送数，超出时丢弃最早的推送
+	PushBufferSize = loadIntFromEnv("GAME_PUSH_BUFFER_SIZE", 256)
+	// PushSessionTTL 断线后推送会话保留的时间
+	PushSessionTTL = loadDurationFromEnv("GAME_PUSH_SESSION_TTL", 2*time.Minute)
+)
+
+// pushSession 一个玩家的推送会话，跨连接保留
+type pushSession struct {
+	id  string
+	uid uint64
+
+	mu         sync.Mutex
+	seq        uint64        // 最近一条推送的序号
+	pending    []*pb.Message // 未确认的推送，序号连续递增，最后一条为 seq
+	player     *Player       // 当前连接，断线后为 nil
+	resuming   bool          // 正在向新连接补发，期间的新推送只放入 pending，由补发流程按顺序发送
+	detachedAt time.Time
+}
+
+// push 分配序号放入 pending 并尝试放入玩家的发送队列，玩家已断线时返回 false（不占用序号）
+// 发送队列已满时推送仍保留在 pending 中，断开这个跟不上的连接，客户端重连后按序号补发
+func (s *pushSession) push(msg *pb.Message) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.player == nil {
+		return false
+	}
+	s.seq++
+	msg.PushSeq = s.seq
+	s.pending = append(s.pending, msg)
+	if len(s.pending) > PushBufferSize {
+		// 客户端长时间不确认，丢弃最早的推送，重连时无法补发则全量同步
+		s.pending = append(s.pending[:0], s.pending[len(s.pending)-PushBufferSize:]...)
+	}
+	if !s.resuming && !s.player.TrySendMessage(msg) && s.player.ctx.Err() == nil {
+		pushOverflowDisconnects.Inc()
+		slog.Warn("Send queue full, disconnecting player to resend pushes on reconnect", "uid", s.uid,
+			"conn_uuid", s.player.ConnUUID, "push_seq", msg.PushSeq)
+		s.player.cancelFunc()
+	}
+	return true
+}
+
+// ack 确认序号不大于 seq 的推送
+func (s *pushSession) ack(seq uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ackLocked(seq)
+}
+
+func (s *pushSession) ackLocked(seq uint64) {
+	n := 0
+	for n < len(s.pending) && s.pending[n].PushSeq <= seq {
+		n++
+	}
+	if n > 0 {
+		s.pending = append(s.pending[:0], s.pending[n:]...)
+	}
+}
+
+// canResumeLocked 序号在 lastAcked 之后的推送是否都还在缓冲区中
+func (s *pushSession) canResumeLocked(lastAcked uint64) bool {
+	return lastAcked <= s.seq && lastAcked+uint64(len(s.pending)) >= s.seq
+}
+
+// resume 将会话绑定到新连接
+// 发送认证响应后补发缺失的推送；发送可能阻塞，因此不持有会话锁，
+// 补发期间的新推送只分配序号放入 pending，补发完成前依次发出，保证推送按序号到达且排在认证响应之后
+func (s *pushSession) resume(p *Player, lastAcked uint64, resync bool, respond func(seq uint64, resync bool)) {
+	s.mu.Lock()
+	if !resync && !s.canResumeLocked(lastAcked) {
+		resync = true
+	}
+	if resync {
+		s.pending = s.pending[:0]
+	} else {
+		s.ackLocked(lastAcked)
+	}
+	seq := s.seq
+	resend := append([]*pb.Message(nil), s.pending...)
+	s.player = p
+	s.resuming = true
+	p.push.Store(s)
+	s.mu.Unlock()
+
+	respond(seq, resync)
+	if resync {
+		pushResyncs.Inc()
+	}
+	if len(resend) > 0 {
+		pushResent.Add(float64(len(resend)))
+		slog.Info("Resent unacknowledged pushes", "uid", s.uid, "push_session", s.id,
+			"from_seq", resend[0].PushSeq, "to_seq", seq)
+	}
+
+	for {
+		for _, msg := range resend {
+			p.SendMessage(msg)
+		}
+		if len(resend) > 0 {
+			seq = resend[len(resend)-1].PushSeq
+		}
+
+		s.mu.Lock()
+		if s.player != p {
+			// 补发期间连接断开或被新连接接管，由新连接的补发流程继续
+			s.mu.Unlock()
+			return
+		}
+		resend = resend[:0]
+		for _, msg := range s.pending {
+			if msg.PushSeq > seq {
+				resend = append(resend, msg)
+			}
+		}
+		if len(resend) == 0 {
+			s.resuming = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+	}
+}
+
+// detach 连接断开，会话保留等待重连；会话已被新连接接管时不做处理
+func (s *pushSession) detach(p *Player) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.player != p {
+		return false
+	}
+	s.player = nil
+	s.detachedAt = time.Now()
+	return true
+}
+
+// expired 断线超过 PushSessionTTL 且没有重连
+func (s *pushSession) expired(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.player == nil && now.Sub(s.detachedAt) >= PushSessionTTL
+}
+
+// PushSessions 按玩家保存推送会话
+type PushSessions struct {
+	mu       sync.Mutex
+	sessions map[uint64]*pushSession
+}
+
+// GlobalPushSessions 全局推送会话
+var GlobalPushSessions = &PushSessions{sessions: make(map[uint64]*pushSession)}
+
+// Attach 认证成功后绑定推送会话
+// 客户端带上的会话ID与保留的会话一致时继续使用该会话并补发缺失的推送，否则创建新会话；
+// 客户端带了会话ID但无法继续（服务器重启、会话过期）时要求全量同步
+func (m *PushSessions) Attach(p *Player, sessionID string, lastAcked uint64, respond func(session *pushSession, seq uint64, resync bool)) {
+	m.mu.Lock()
+	session, ok := m.sessions[p.Uid]
+	resync := false
+	if !ok || session.id != sessionID {
+		resync = sessionID != "" || lastAcked > 0
+		session = &pushSession{id: GenerateShortUUID(), uid: p.Uid}
+		m.sessions[p.Uid] = session
+	}
+	m.mu.Unlock()
+
+	session.resume(p, lastAcked, resync, func(seq uint64, resync bool) {
+		respond(session, seq, resync)
+	})
+}
+
+// Detach 连接断开，会话在 PushSessionTTL 后没有重连则删除
+func (m *PushSessions) Detach(p *Player) {
+	session := p.push.Load()
+	if session == nil || !session.detach(p) {
+		return
+	}
+	time.AfterFunc(PushSessionTTL, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.sessions[session.uid] == session && session.expired(time.Now()) {
+			delete(m.sessions, session.uid)
+			slog.Info("Push session expired", "uid", session.uid, "push_session", session.id)
+		}
+	})
+}
+
+// Push 发送一条推送，玩家没有推送会话（未认证）时直接发送
+func (p *Player) Push(msg *pb.Message) bool {
+	if session := p.push.Load(); session != nil {
+		return session.push(msg)
+	}
+	return p.TrySendMessage(msg)
+}
+
+// ackPush 处理客户端的推送确认
+func (p *Player) ackPush(seq uint64) {
+	if session := p.push.Load(); session != nil && seq > 0 {
+		session.ack(seq)
+	}
+}
+
+// HandlePushAck 推送确认，没有响应
+func (p *Player) HandlePushAck(msg *pb.Message) error {
+	var req pb.PushAck
+	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid PushAck: %v", err)
+	}
+	p.ackPush(req.Seq)
+	return nil
+}
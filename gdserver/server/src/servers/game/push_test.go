@@ -0,0 +1,172 @@
+package main
+
+import (
+	pb "proto"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newPushMsg() *pb.Message {
+	return &pb.Message{Id: pb.MessageId_SYSTEM_MESSAGE_NOTIFICATION, MsgSerialNo: -1}
+}
+
+// authMarker 代替认证响应，检查补发的推送排在它之后
+var authMarker = &pb.Message{Id: pb.MessageId_AUTH_RESPONSE}
+
+// pushedSession 第一个连接推送 n 条后断线，返回保留的会话
+func pushedSession(t *testing.T, m *PushSessions, n int) *pushSession {
+	t.Helper()
+	p := newTestPlayer()
+	var session *pushSession
+	m.Attach(p, "", 0, func(s *pushSession, _ uint64, _ bool) { session = s })
+	for i := 0; i < n; i++ {
+		if !session.push(newPushMsg()) {
+			t.Fatalf("push %d rejected", i+1)
+		}
+	}
+	session.detach(p)
+	return session
+}
+
+// drainSeqs 读出发送队列，认证响应记为 0，推送记为序号
+func drainSeqs(p *Player) []uint64 {
+	var seqs []uint64
+	for {
+		select {
+		case msg := <-p.SendChan:
+			seqs = append(seqs, msg.PushSeq)
+		default:
+			return seqs
+		}
+	}
+}
+
+func sessionID(s *pushSession) string { return s.id }
+
+func TestPushSessionResume(t *testing.T) {
+	defer func(size int) { PushBufferSize = size }(PushBufferSize)
+	PushBufferSize = 4
+
+	tests := []struct {
+		name       string
+		pushed     int
+		sessionID  func(s *pushSession) string
+		lastAcked  uint64
+		wantResync bool
+		wantSeq    uint64
+		wantSent   []uint64
+	}{
+		{"resend after gap", 4, sessionID, 1, false, 4, []uint64{0, 2, 3, 4}},
+		{"nothing missing", 4, sessionID, 4, false, 4, []uint64{0}},
+		{"gap already dropped", 6, sessionID, 1, true, 6, []uint64{0}},
+		{"ack beyond seq", 4, sessionID, 9, true, 4, []uint64{0}},
+		{"unknown session", 4, func(*pushSession) string { return "stale" }, 1, true, 0, []uint64{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &PushSessions{sessions: make(map[uint64]*pushSession)}
+			previous := pushedSession(t, m, tt.pushed)
+
+			p := newTestPlayer()
+			var (
+				gotSeq    uint64
+				gotResync bool
+			)
+			m.Attach(p, tt.sessionID(previous), tt.lastAcked, func(_ *pushSession, seq uint64, resync bool) {
+				gotSeq, gotResync = seq, resync
+				p.SendMessage(authMarker)
+			})
+
+			if gotSeq != tt.wantSeq || gotResync != tt.wantResync {
+				t.Errorf("respond(seq=%d, resync=%v), want (%d, %v)", gotSeq, gotResync, tt.wantSeq, tt.wantResync)
+			}
+			if got := drainSeqs(p); !slices.Equal(got, tt.wantSent) {
+				t.Errorf("sent %v, want %v", got, tt.wantSent)
+			}
+		})
+	}
+}
+
+// TestPushDuringResume 补发期间的新推送不被阻塞，且排在补发的推送之后
+func TestPushDuringResume(t *testing.T) {
+	m := &PushSessions{sessions: make(map[uint64]*pushSession)}
+	session := pushedSession(t, m, 3)
+
+	p := newTestPlayer()
+	p.SendChan = make(chan *pb.Message) // 客户端不读取，补发阻塞
+
+	resumed := make(chan struct{})
+	go func() {
+		defer close(resumed)
+		m.Attach(p, session.id, 1, func(*pushSession, uint64, bool) { p.SendMessage(authMarker) })
+	}()
+
+	if got := (<-p.SendChan).PushSeq; got != 0 {
+		t.Fatalf("first frame seq = %d, want auth response", got)
+	}
+
+	pushed := make(chan bool)
+	go func() { pushed <- p.Push(newPushMsg()) }()
+	select {
+	case ok := <-pushed:
+		if !ok {
+			t.Fatal("push during resume rejected")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("push blocked behind resume")
+	}
+
+	var got []uint64
+	for len(got) < 3 {
+		select {
+		case msg := <-p.SendChan:
+			got = append(got, msg.PushSeq)
+		case <-time.After(time.Second):
+			t.Fatalf("received %v, resume stalled", got)
+		}
+	}
+	<-resumed
+	if want := []uint64{2, 3, 4}; !slices.Equal(got, want) {
+		t.Fatalf("sent %v, want %v", got, want)
+	}
+	if session.resuming {
+		t.Fatal("session still resuming")
+	}
+}
+
+// TestPushWhenSendQueueFull 发送队列已满的推送保留序号并断开连接，重连后补发
+func TestPushWhenSendQueueFull(t *testing.T) {
+	m := &PushSessions{sessions: make(map[uint64]*pushSession)}
+	p := newTestPlayer()
+	p.SendChan = make(chan *pb.Message, 1)
+	var session *pushSession
+	m.Attach(p, "", 0, func(s *pushSession, _ uint64, _ bool) { session = s })
+
+	for i := 0; i < 3; i++ {
+		if !session.push(newPushMsg()) {
+			t.Fatalf("push %d rejected", i+1)
+		}
+	}
+	if got := drainSeqs(p); !slices.Equal(got, []uint64{1}) {
+		t.Fatalf("sent %v, want [1]", got)
+	}
+	select {
+	case <-p.ctx.Done():
+	default:
+		t.Fatal("slow connection not disconnected")
+	}
+	session.detach(p)
+
+	// 客户端只收到了第 1 条，重连后补发 2、3
+	next := newTestPlayer()
+	m.Attach(next, session.id, 1, func(_ *pushSession, seq uint64, resync bool) {
+		if seq != 3 || resync {
+			t.Errorf("respond(seq=%d, resync=%v), want (3, false)", seq, resync)
+		}
+		next.SendMessage(authMarker)
+	})
+	if got := drainSeqs(next); !slices.Equal(got, []uint64{0, 2, 3}) {
+		t.Fatalf("resent %v, want [0 2 3]", got)
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"log/slog"
+	pb "proto"
+	"time"
+)
+
+// RateLimit 令牌桶配置：每秒补充 Rate 个令牌，最多积攒 Burst 个（允许的突发请求数）
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+var (
+	// ConnRateLimit 单个连接所有消息合计的限制
+	ConnRateLimit = RateLimit{
+		Rate:  float64(loadIntFromEnv("GAME_CONN_RATE", 50)),
+		Burst: loadIntFromEnv("GAME_CONN_BURST", 100),
+	}
+
+	// MessageRateLimits 按消息类型的限制，未列出的类型只受连接总量限制
+	MessageRateLimits = map[pb.MessageId]RateLimit{
+		pb.MessageId_AUTH_REQUEST:              {Rate: 1, Burst: 3},
+		pb.MessageId_HEARTBEAT_REQUEST:         {Rate: 1, Burst: 5},
+		pb.MessageId_PUSH_ACK:                  {Rate: 20, Burst: 40},
+		pb.MessageId_LOGOUT_REQUEST:            {Rate: 1, Burst: 3},
+		pb.MessageId_GET_USER_INFO_REQUEST:     {Rate: 2, Burst: 5},
+		pb.MessageId_DRAW_CARD_REQUEST:         {Rate: 2, Burst: 5},
+		pb.MessageId_GET_ROOM_LIST_REQUEST:     {Rate: 2, Burst: 5},
+		pb.MessageId_CREATE_ROOM_REQUEST:       {Rate: 1, Burst: 3},
+		pb.MessageId_JOIN_ROOM_REQUEST:         {Rate: 2, Burst: 5},
+		pb.MessageId_JOIN_ROOM_BY_CODE_REQUEST: {Rate: 2, Burst: 5},
+		pb.MessageId_LEAVE_ROOM_REQUEST:        {Rate: 2, Burst: 5},
+		pb.MessageId_GET_READY_REQUEST:         {Rate: 2, Burst: 5},
+		pb.MessageId_SEND_ROOM_INVITE_REQUEST:  {Rate: 1, Burst: 5},
+		pb.MessageId_MATCH_REQUEST:             {Rate: 1, Burst: 3},
+		pb.MessageId_CANCEL_MATCH_REQUEST:      {Rate: 1, Burst: 3},
+		pb.MessageId_GAME_ACTION_REQUEST:       {Rate: 30, Burst: 60}, // 包含角色移动，频率较高
+	}
+
+	// RateLimitMaxViolations 在 RateLimitWindow 内被限流超过该次数的连接会被断开
+	RateLimitMaxViolations = loadIntFromEnv("GAME_RATE_LIMIT_MAX_VIOLATIONS", 20)
+	RateLimitWindow        = loadDurationFromEnv("GAME_RATE_LIMIT_WINDOW", 10*time.Second)
+)
+
+// tokenBucket 令牌桶
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	return &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	b.tokens = min(float64(b.limit.Burst), b.tokens+now.Sub(b.last).Seconds()*b.limit.Rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// rateLimiter 单个连接的限流状态，只在读协程中使用
+type rateLimiter struct {
+	conn        *tokenBucket
+	messages    map[pb.MessageId]*tokenBucket
+	violations  int       // 当前窗口内被限流的次数
+	windowStart time.Time // 当前窗口开始时间
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		conn:     newTokenBucket(ConnRateLimit, time.Now()),
+		messages: make(map[pb.MessageId]*tokenBucket),
+	}
+}
+
+// allow 消息是否放行；不放行时 abusive 表示窗口内被限流次数已超过上限，需要断开连接
+func (l *rateLimiter) allow(msgID pb.MessageId, now time.Time) (ok, abusive bool) {
+	if limit, limited := MessageRateLimits[msgID]; limited {
+		bucket, exists := l.messages[msgID]
+		if !exists {
+			bucket = newTokenBucket(limit, now)
+			l.messages[msgID] = bucket
+		}
+		if !bucket.allow(now) {
+			return false, l.violate(now)
+		}
+	}
+	if !l.conn.allow(now) {
+		return false, l.violate(now)
+	}
+	return true, false
+}
+
+func (l *rateLimiter) violate(now time.Time) bool {
+	if now.Sub(l.windowStart) > RateLimitWindow {
+		l.windowStart = now
+		l.violations = 0
+	}
+	l.violations++
+	return l.violations > RateLimitMaxViolations
+}
+
+// checkRateLimit 读协程中检查消息是否超过限流，超过时回复 RATE_LIMITED
+// allowed 为 false 时丢弃该消息，keep 为 false 时需要断开连接
+func (p *Player) checkRateLimit(msg *pb.Message) (allowed, keep bool) {
+	ok, abusive := p.limiter.allow(msg.GetId(), time.Now())
+	if ok {
+		return true, true
+	}
+
+	rateLimited.WithLabelValues(msg.GetId().String()).Inc()
+	if abusive {
+		rateLimitDisconnects.Inc()
+		slog.Warn("Disconnecting client for exceeding rate limits", "conn_uuid", p.ConnUUID, "uid", p.Uid,
+			"msg_id", msg.GetId(), "violations", p.limiter.violations, "window", RateLimitWindow)
+		return false, false
+	}
+	slog.Warn("Message rate limited", "conn_uuid", p.ConnUUID, "uid", p.Uid, "msg_id", msg.GetId(),
+		"violations", p.limiter.violations)
+	p.trySendErrorResponse(msg, pb.ErrorCode_RATE_LIMITED)
+	return false, true
+}
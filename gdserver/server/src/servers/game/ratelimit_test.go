@@ -0,0 +1,128 @@
+package main
+
+import (
+	pb "proto"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestPlayer 创建一个已认证、不带连接的玩家，发送的消息留在 SendChan 中
+func newTestPlayer() *Player {
+	p := NewPlayer("test", nil)
+	p.Uid = 1
+	p.Authenticated = true
+	return p
+}
+
+func testMsg(id pb.MessageId, serial int32) *pb.Message {
+	return &pb.Message{Id: id, MsgSerialNo: serial}
+}
+
+// nextResponse 读取一条已发送的消息，没有时返回 nil
+func nextResponse(p *Player) *pb.Message {
+	select {
+	case msg := <-p.SendChan:
+		return msg
+	default:
+		return nil
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	type step struct {
+		after time.Duration // 距上一步的时间
+		want  bool
+	}
+	tests := []struct {
+		name  string
+		limit RateLimit
+		steps []step
+	}{
+		{"burst then reject", RateLimit{Rate: 1, Burst: 3},
+			[]step{{0, true}, {0, true}, {0, true}, {0, false}}},
+		{"refill at rate", RateLimit{Rate: 2, Burst: 1},
+			[]step{{0, true}, {0, false}, {250 * time.Millisecond, false}, {250 * time.Millisecond, true}}},
+		{"refill capped at burst", RateLimit{Rate: 10, Burst: 2},
+			[]step{{0, true}, {0, true}, {time.Hour, true}, {0, true}, {0, false}}},
+		{"fractional rate", RateLimit{Rate: 0.5, Burst: 1},
+			[]step{{0, true}, {time.Second, false}, {time.Second, true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(1000, 0)
+			b := newTokenBucket(tt.limit, now)
+			for i, s := range tt.steps {
+				now = now.Add(s.after)
+				if got := b.allow(now); got != s.want {
+					t.Fatalf("step %d: allow = %v, want %v", i, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func withRateLimits(t *testing.T, conn RateLimit, messages map[pb.MessageId]RateLimit, maxViolations int, window time.Duration) {
+	t.Helper()
+	c, m, v, w := ConnRateLimit, MessageRateLimits, RateLimitMaxViolations, RateLimitWindow
+	ConnRateLimit, MessageRateLimits, RateLimitMaxViolations, RateLimitWindow = conn, messages, maxViolations, window
+	t.Cleanup(func() {
+		ConnRateLimit, MessageRateLimits, RateLimitMaxViolations, RateLimitWindow = c, m, v, w
+	})
+}
+
+func TestRateLimiter(t *testing.T) {
+	withRateLimits(t, RateLimit{Rate: 1, Burst: 4},
+		map[pb.MessageId]RateLimit{pb.MessageId_MATCH_REQUEST: {Rate: 1, Burst: 1}}, 2, 10*time.Second)
+
+	type step struct {
+		id          pb.MessageId
+		after       time.Duration
+		wantOK      bool
+		wantAbusive bool
+	}
+	steps := []step{
+		{pb.MessageId_MATCH_REQUEST, 0, true, false},
+		{pb.MessageId_MATCH_REQUEST, 0, false, false},    // 消息类型限流，第 1 次违规
+		{pb.MessageId_GET_READY_REQUEST, 0, true, false}, // 未单独限制的类型只受连接总量限制
+		{pb.MessageId_GET_READY_REQUEST, 0, true, false},
+		{pb.MessageId_GET_READY_REQUEST, 0, true, false},
+		{pb.MessageId_GET_READY_REQUEST, 0, false, false},               // 连接总量限流，第 2 次违规
+		{pb.MessageId_GET_READY_REQUEST, 11 * time.Second, true, false}, // 窗口过后恢复
+		{pb.MessageId_MATCH_REQUEST, 0, true, false},
+		{pb.MessageId_MATCH_REQUEST, 0, false, false}, // 新窗口第 1 次违规
+		{pb.MessageId_MATCH_REQUEST, 0, false, false},
+		{pb.MessageId_MATCH_REQUEST, 0, false, true}, // 超过 2 次，断开
+	}
+
+	l := newRateLimiter()
+	now := time.Now()
+	for i, s := range steps {
+		now = now.Add(s.after)
+		ok, abusive := l.allow(s.id, now)
+		if ok != s.wantOK || abusive != s.wantAbusive {
+			t.Fatalf("step %d (%v): allow = (%v, %v), want (%v, %v)", i, s.id, ok, abusive, s.wantOK, s.wantAbusive)
+		}
+	}
+}
+
+func TestCheckRateLimitResponds(t *testing.T) {
+	withRateLimits(t, RateLimit{Rate: 0.001, Burst: 1}, map[pb.MessageId]RateLimit{}, 1, time.Minute)
+	p := newTestPlayer()
+	p.limiter = newRateLimiter()
+
+	if allowed, keep := p.checkRateLimit(testMsg(pb.MessageId_MATCH_REQUEST, 1)); !allowed || !keep {
+		t.Fatalf("first message: allowed=%v keep=%v", allowed, keep)
+	}
+	if allowed, keep := p.checkRateLimit(testMsg(pb.MessageId_MATCH_REQUEST, 2)); allowed || !keep {
+		t.Fatalf("limited message: allowed=%v keep=%v", allowed, keep)
+	}
+	var match pb.MatchResponse
+	if rsp := nextResponse(p); rsp == nil || rsp.GetMsgSerialNo() != 2 || proto.Unmarshal(rsp.GetData(), &match) != nil || match.Ret != pb.ErrorCode_RATE_LIMITED {
+		t.Fatalf("response = %v, want MATCH_RESPONSE RATE_LIMITED", rsp)
+	}
+	if allowed, keep := p.checkRateLimit(testMsg(pb.MessageId_MATCH_REQUEST, 3)); allowed || keep {
+		t.Fatalf("abusive client: allowed=%v keep=%v, want disconnect", allowed, keep)
+	}
+}
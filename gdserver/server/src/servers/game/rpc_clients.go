@@ -0,0 +1,73 @@
+package main
+
+import (
+	"common/discovery"
+	"common/rpc"
+	"common/tracing"
+	"context"
+	"fmt"
+	"log/slog"
+	pb "proto"
+	"sort"
+	"sync"
+	"time"
+)
+
+// rpcClients 到战斗服、匹配服的共享 gRPC 长连接（按地址复用，带重试和熔断）
+// 调用方不能关闭从这里拿到的连接
+var rpcClients = rpc.NewClientPool(rpc.DefaultBreakerConfig, tracing.DialOption())
+
+// defaultMatchServerAddr 服务发现中没有匹配服实例时使用的默认地址（本地单实例开发）
+var defaultMatchServerAddr = fmt.Sprintf("127.0.0.1:%d", rpc.MatchServiceGRPCPort)
+
+// matchAddrTTL 匹配服地址的缓存时间，避免每个匹配请求都查询服务发现
+const matchAddrTTL = 5 * time.Second
+
+// GlobalMatchDiscovery 匹配服的服务发现，为 nil 时使用默认地址
+var GlobalMatchDiscovery *discovery.RedisDiscovery
+
+var matchAddrCache struct {
+	sync.Mutex
+	addr      string
+	expiresAt time.Time
+}
+
+// matchServerAddr 获取匹配服地址
+// 匹配队列在匹配服内存中，多个实例时固定选择实例ID最小的一个，保证同一玩家的匹配和取消发到同一实例
+func matchServerAddr() string {
+	if GlobalMatchDiscovery == nil {
+		return defaultMatchServerAddr
+	}
+
+	matchAddrCache.Lock()
+	defer matchAddrCache.Unlock()
+	if matchAddrCache.addr != "" && time.Now().Before(matchAddrCache.expiresAt) {
+		return matchAddrCache.addr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	addr := defaultMatchServerAddr
+	instances, err := GlobalMatchDiscovery.Discover(ctx, discovery.MatchServiceName)
+	if err != nil {
+		slog.Error("Failed to discover match servers", "error", err)
+	} else if len(instances) > 0 {
+		sort.Slice(instances, func(i, j int) bool { return instances[i].InstanceID < instances[j].InstanceID })
+		addr = instances[0].Address
+	}
+
+	matchAddrCache.addr = addr
+	matchAddrCache.expiresAt = time.Now().Add(matchAddrTTL)
+	return addr
+}
+
+// matchClient 匹配服的共享客户端
+func matchClient() (pb.MatchRpcServiceClient, error) {
+	addr := matchServerAddr()
+	conn, err := rpcClients.Conn(addr)
+	if err != nil {
+		return nil, fmt.Errorf("连接MatchServer失败 %s: %w", addr, err)
+	}
+	return pb.NewMatchRpcServiceClient(conn), nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+)
+
+// securityLogger 安全日志（签名校验失败等），GAME_SECURITY_LOG 指定文件时单独写入该文件（JSON 行），
+// 否则写入服务日志，都带有 category=security 便于检索
+var securityLogger = newSecurityLogger(os.Getenv("GAME_SECURITY_LOG"))
+
+func newSecurityLogger(path string) *slog.Logger {
+	if path == "" {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		slog.Error("Failed to open security log, using service log", "path", path, "error", err)
+		return nil
+	}
+	return slog.New(slog.NewJSONHandler(f, nil)).With("category", "security")
+}
+
+// logSecurityEvent 记录一条安全事件
+func logSecurityEvent(event string, args ...any) {
+	logger := securityLogger
+	if logger == nil {
+		logger = slog.Default().With("category", "security")
+	}
+	logger.Warn(event, args...)
+}
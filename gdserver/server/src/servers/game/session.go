@@ -0,0 +1,116 @@
+package main
+
+import (
+	"common/session"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	pb "proto"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// revokeReasons 会话撤销广播中的原因对应的通知原因
+var revokeReasons = map[string]pb.SessionRevokeReason{
+	session.ReasonAdmin:  pb.SessionRevokeReason_SESSION_REVOKE_ADMIN,
+	session.ReasonLogout: pb.SessionRevokeReason_SESSION_REVOKE_LOGOUT,
+}
+
+// SubscribeSessionRevocations 订阅会话撤销广播，断开本服使用该会话的连接
+func SubscribeSessionRevocations() error {
+	return GlobalRedis.Subscribe(session.RevokedChannel, handleSessionRevoked)
+}
+
+func handleSessionRevoked(payload string) {
+	var event session.RevokeEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil || event.SessionID == "" {
+		slog.Error("Invalid session revocation event", "payload", payload, "error", err)
+		return
+	}
+
+	for _, p := range GlobalManager.GetPlayersBySession(event.SessionID) {
+		if p.closing.Load() {
+			continue // 已在关闭（如本连接发起的登出）
+		}
+		slog.Info("Session revoked, disconnecting player", "uid", p.Uid, "conn_uuid", p.ConnUUID, "reason", event.Reason)
+		sessionRevocations.WithLabelValues(event.Reason).Inc()
+		p.kickSessionRevoked(revokeReasons[event.Reason])
+	}
+}
+
+// kickSessionRevoked 通知会话已失效并关闭连接
+func (p *Player) kickSessionRevoked(reason pb.SessionRevokeReason) {
+	p.kick(&pb.Message{
+		Id:          pb.MessageId_SESSION_REVOKED_NOTIFICATION,
+		MsgSerialNo: -1,
+		Data:        mustMarshal(&pb.SessionRevokedNotification{Reason: reason}),
+	})
+}
+
+// kick 发送最后一条消息后关闭连接，已安排关闭的连接不重复处理
+// 发送队列中追加关闭标记（nil），发送协程写完之前的消息后退出并关闭连接；队列已满时直接关闭
+func (p *Player) kick(msg *pb.Message) {
+	if p.closing.Swap(true) {
+		return
+	}
+	if !p.TrySendMessage(msg) {
+		p.cancelFunc()
+		return
+	}
+	p.closeAfterSend()
+}
+
+// closeAfterSend 已入队的消息发送完后关闭连接
+func (p *Player) closeAfterSend() {
+	if !p.TrySendMessage(nil) {
+		p.cancelFunc()
+	}
+}
+
+// checkSessionExpiry 会话到期后重新读取会话（客户端可能已通过登录服务器刷新），
+// 仍然无效时断开连接；返回 false 表示连接将被关闭
+func (p *Player) checkSessionExpiry() bool {
+	if !p.Authenticated || p.SessionExpiry.IsZero() || time.Now().Before(p.SessionExpiry) {
+		return true
+	}
+
+	valid, sessionData, err := validateSession(p.SessionID)
+	if err != nil {
+		// Redis 故障时不断开，下次心跳再检查
+		slog.Error("Session revalidation error", "uid", p.Uid, "error", err)
+		return true
+	}
+	if valid {
+		p.SessionExpiry = time.Unix(sessionData.ExpiresAt, 0)
+		return true
+	}
+
+	slog.Info("Session expired, disconnecting player", "uid", p.Uid, "conn_uuid", p.ConnUUID)
+	sessionRevocations.WithLabelValues("expired").Inc()
+	p.kickSessionRevoked(pb.SessionRevokeReason_SESSION_REVOKE_EXPIRED)
+	return false
+}
+
+// HandleLogoutRequest 登出：删除会话，回复后关闭连接
+// 连接按正常退出清理（离开房间、退出匹配队列），其他使用同一会话的连接通过撤销广播断开
+func (p *Player) HandleLogoutRequest(msg *pb.Message) error {
+	var req pb.LogoutRequest
+	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid LogoutRequest: %v", err)
+	}
+
+	// 先标记关闭，本服收到自己的撤销广播时不再重复通知
+	if p.closing.Swap(true) {
+		return nil
+	}
+	if err := session.Revoke(GlobalRedis, p.SessionID, session.ReasonLogout); err != nil {
+		p.closing.Store(false)
+		return fmt.Errorf("revoke session on logout: %w", err)
+	}
+
+	slog.Info("Player logged out", "uid", p.Uid, "conn_uuid", p.ConnUUID)
+	p.SendResponse(msg, mustMarshal(&pb.LogoutResponse{Ret: pb.ErrorCode_OK}))
+	p.closeAfterSend()
+	return nil
+}
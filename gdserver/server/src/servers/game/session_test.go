@@ -0,0 +1,154 @@
+package main
+
+import (
+	"common/session"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	pb "proto"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// addSessionPlayer 注册一个已认证的连接（不启动协程），测试结束后删除
+func addSessionPlayer(t *testing.T, uid uint64, sessionID string) *Player {
+	t.Helper()
+	p := NewPlayer(GenerateShortUUID(), nil)
+	p.Uid = uid
+	p.Authenticated = true
+	p.SessionID = sessionID
+	GlobalManager.players.Store(p.ConnUUID, p)
+	GlobalManager.OnPlayerUinSet(p.ConnUUID)
+	t.Cleanup(func() { GlobalManager.DeletePlayer(p.ConnUUID) })
+	return p
+}
+
+// expectRevoked 检查发送队列中是会话失效通知和关闭标记
+func expectRevoked(t *testing.T, p *Player, reason pb.SessionRevokeReason) {
+	t.Helper()
+	msg := nextResponse(p)
+	if msg == nil || msg.GetId() != pb.MessageId_SESSION_REVOKED_NOTIFICATION {
+		t.Fatalf("uid %d: got %v, want SESSION_REVOKED_NOTIFICATION", p.Uid, msg)
+	}
+	var notify pb.SessionRevokedNotification
+	if err := proto.Unmarshal(msg.GetData(), &notify); err != nil || notify.Reason != reason {
+		t.Fatalf("uid %d: reason = %v (%v), want %v", p.Uid, notify.Reason, err, reason)
+	}
+	select {
+	case marker := <-p.SendChan:
+		if marker != nil {
+			t.Fatalf("uid %d: got %v after notification, want close marker", p.Uid, marker.GetId())
+		}
+	default:
+		t.Fatalf("uid %d: no close marker", p.Uid)
+	}
+	if !p.closing.Load() {
+		t.Fatalf("uid %d: not closing", p.Uid)
+	}
+}
+
+func TestHandleSessionRevoked(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+		want   pb.SessionRevokeReason
+	}{
+		{"logout", session.ReasonLogout, pb.SessionRevokeReason_SESSION_REVOKE_LOGOUT},
+		{"admin", session.ReasonAdmin, pb.SessionRevokeReason_SESSION_REVOKE_ADMIN},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := uint64(900000 + i*10)
+			target := addSessionPlayer(t, base+1, "s-revoked")
+			other := addSessionPlayer(t, base+2, "s-other")
+			closing := addSessionPlayer(t, base+3, "s-revoked")
+			closing.closing.Store(true) // 本连接发起的登出
+
+			payload, _ := json.Marshal(session.RevokeEvent{SessionID: "s-revoked", Reason: tt.reason})
+			handleSessionRevoked(string(payload))
+
+			expectRevoked(t, target, tt.want)
+			for _, p := range []*Player{other, closing} {
+				if msg := nextResponse(p); msg != nil {
+					t.Errorf("uid %d: unexpected %v", p.Uid, msg.GetId())
+				}
+			}
+		})
+	}
+}
+
+func TestHandleSessionRevokedIgnoresInvalidEvents(t *testing.T) {
+	p := addSessionPlayer(t, 900101, "")
+	for _, payload := range []string{"not json", `{"reason":"admin"}`} {
+		handleSessionRevoked(payload)
+	}
+	if msg := nextResponse(p); msg != nil || p.closing.Load() {
+		t.Fatalf("invalid event kicked player: %v", msg)
+	}
+}
+
+func TestKick(t *testing.T) {
+	t.Run("queue full closes immediately", func(t *testing.T) {
+		p := newTestPlayer()
+		p.SendChan = make(chan *pb.Message)
+		p.kickSessionRevoked(pb.SessionRevokeReason_SESSION_REVOKE_EXPIRED)
+		select {
+		case <-p.ctx.Done():
+		default:
+			t.Fatal("context not cancelled")
+		}
+	})
+
+	t.Run("only once", func(t *testing.T) {
+		p := newTestPlayer()
+		p.kickSessionRevoked(pb.SessionRevokeReason_SESSION_REVOKE_ADMIN)
+		p.kickSessionRevoked(pb.SessionRevokeReason_SESSION_REVOKE_LOGOUT)
+		expectRevoked(t, p, pb.SessionRevokeReason_SESSION_REVOKE_ADMIN)
+		if msg := nextResponse(p); msg != nil {
+			t.Fatalf("second kick sent %v", msg.GetId())
+		}
+	})
+}
+
+// runPipePlayer 在 net.Pipe 上运行玩家协程，返回客户端一端；Uid 为 0，退出时不清理房间和匹配
+func runPipePlayer(t *testing.T) (*Player, net.Conn) {
+	t.Helper()
+	server, client := net.Pipe()
+	p := NewPlayer(GenerateShortUUID(), NewTCPConnection(server, ProtoCodec{}))
+	done := make(chan struct{})
+	go func() {
+		p.Run()
+		close(done)
+	}()
+	t.Cleanup(func() {
+		client.Close()
+		<-done
+	})
+	return p, client
+}
+
+// expectClosedAfter 客户端依次收到 ids 对应的消息，随后连接被服务器关闭
+func expectClosedAfter(t *testing.T, client net.Conn, ids ...pb.MessageId) {
+	t.Helper()
+	client.SetReadDeadline(time.Now().Add(2 * time.Second))
+	conn := NewTCPConnection(client, ProtoCodec{})
+	for _, id := range ids {
+		msg, err := conn.ReadMessage()
+		if err != nil || msg.GetId() != id {
+			t.Fatalf("read %v (%v), want %v", msg.GetId(), err, id)
+		}
+	}
+	if msg, err := conn.ReadMessage(); !errors.Is(err, io.EOF) {
+		t.Fatalf("read %v (%v) after final message, want connection closed", msg.GetId(), err)
+	}
+}
+
+// TestKickClosesConnection 被踢下线的连接在最后一条消息发出后立即关闭，不等客户端的下一条消息或读超时
+func TestKickClosesConnection(t *testing.T) {
+	p, client := runPipePlayer(t)
+	p.kickSessionRevoked(pb.SessionRevokeReason_SESSION_REVOKE_ADMIN)
+	expectClosedAfter(t, client, pb.MessageId_SESSION_REVOKED_NOTIFICATION)
+}
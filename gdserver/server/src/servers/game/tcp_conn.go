@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	pb "proto"
+	"time"
+)
+
+// TCPConnection 长度头分帧的 TCP 连接
+type TCPConnection struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	codec  Codec
+}
+
+func NewTCPConnection(conn net.Conn, codec Codec) *TCPConnection {
+	return &TCPConnection{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		codec:  codec,
+	}
+}
+
+func (t *TCPConnection) ReadMessage() (*pb.Message, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(t.reader, header[:]); err != nil {
+		return nil, err
+	}
+
+	length := int(binary.LittleEndian.Uint32(header[:]))
+	if length <= 0 || length > MaxFrameSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, length)
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(t.reader, body); err != nil {
+		return nil, err
+	}
+
+	var msg pb.Message
+	if err := t.codec.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedMessage, err)
+	}
+	return &msg, nil
+}
+
+func (t *TCPConnection) WriteMessage(msg *pb.Message) error {
+	data, err := t.codec.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = t.conn.Write(appendFrameHeader(data))
+	return err
+}
+
+func (t *TCPConnection) Codec() Codec {
+	return t.codec
+}
+
+func (t *TCPConnection) Close() error {
+	return t.conn.Close()
+}
+
+func (t *TCPConnection) RemoteAddr() net.Addr {
+	return t.conn.RemoteAddr()
+}
+
+func (t *TCPConnection) SetReadDeadline(deadline time.Time) error {
+	return t.conn.SetReadDeadline(deadline)
+}
+
+func (t *TCPConnection) SetWriteDeadline(deadline time.Time) error {
+	return t.conn.SetWriteDeadline(deadline)
+}
+
+// appendFrameHeader 在消息体前加上 4 字节小端长度头
+func appendFrameHeader(data []byte) []byte {
+	frame := make([]byte, frameHeaderSize+len(data))
+	binary.LittleEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[frameHeaderSize:], data)
+	return frame
+}
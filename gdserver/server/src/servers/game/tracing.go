@@ -0,0 +1,61 @@
+package main
+
+import (
+	"common/tracing"
+	"context"
+	pb "proto"
+	"time"
+)
+
+// startMessageSpan 为一条客户端消息开始调用链，处理期间下游 RPC 通过 requestContext 继承追踪上下文
+func (p *Player) startMessageSpan(msg *pb.Message) tracing.Span {
+	ctx, span := tracing.StartWithKind(context.Background(), "game."+msg.GetId().String(), tracing.KindServer,
+		tracing.Uint64("player_id", p.Uid),
+		tracing.String("msg_id", msg.GetId().String()),
+		tracing.Int("msg_serial_no", int(msg.GetMsgSerialNo())))
+	p.setMessageContext(msg, ctx)
+	return span
+}
+
+// endMessageSpan 结束消息的调用链
+func (p *Player) endMessageSpan(msg *pb.Message, span tracing.Span) {
+	p.setMessageContext(msg, nil)
+	span.End()
+}
+
+// messageContext 正在处理的消息的上下文（追踪和处理时限），没有时为 Background
+// 不同类别的消息可能在不同协程中同时处理（见 pipeline.go），因此按消息保存
+func (p *Player) messageContext(msg *pb.Message) context.Context {
+	if msg != nil {
+		if ctx, ok := p.msgCtxs.Load(msg); ok {
+			return ctx.(context.Context)
+		}
+	}
+	return context.Background()
+}
+
+// setMessageContext 设置消息的上下文，ctx 为 nil 时删除
+func (p *Player) setMessageContext(msg *pb.Message, ctx context.Context) {
+	if ctx == nil {
+		p.msgCtxs.Delete(msg)
+		return
+	}
+	p.msgCtxs.Store(msg, ctx)
+}
+
+// requestContext 处理消息时调用下游服务使用的 ctx，携带消息的追踪上下文和处理时限
+// 不属于某条消息的调用（如退出清理）msg 传 nil
+func (p *Player) requestContext(msg *pb.Message, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(p.messageContext(msg), timeout)
+}
+
+// notificationContext 战斗服/匹配服推送的通知所属的调用链
+func notificationContext(notification *pb.PlayerNotification) (context.Context, tracing.Span) {
+	ctx := tracing.ContextWithTraceparent(context.Background(), notification.Traceparent)
+	if !tracing.SpanContextFromContext(ctx).IsValid() {
+		return ctx, tracing.SpanFromContext(ctx)
+	}
+	return tracing.StartWithKind(ctx, "game.deliver."+notification.MsgId.String(), tracing.KindConsumer,
+		tracing.Uint64("player_id", notification.BeNotifiedUid),
+		tracing.Int64("seq", int64(notification.Seq)))
+}
@@ -12,11 +12,10 @@ import (
 
 //player 与room 之间的映射关系
 
-func (p *Player) HandleGetUserInfoRequest(msg *pb.Message) {
+func (p *Player) HandleGetUserInfoRequest(msg *pb.Message) error {
 	var req pb.GetUserInfoRequest
 	if err := proto.Unmarshal(msg.GetData(), &req); err != nil {
-		slog.Error("Failed to parse GetUserInfoRequest Request", "error", err)
-		return
+		return NewHandlerError(pb.ErrorCode_INVALID_PARAM, "invalid GetUserInfoRequest: %v", err)
 	}
 
 	//user:{uid} HashMap	{"name": "user_100001", "level": 1, "exp": 0}	永久	用户信息（JSON 格式），包括昵称、等级、经验等。
@@ -24,10 +23,7 @@ func (p *Player) HandleGetUserInfoRequest(msg *pb.Message) {
 	//在redis中查找用户信息，如果没有，则创建一个新的用户
 	//如果有，则返回用户信息
 	if req.GetUid() == 0 || req.GetUid() != p.Uid {
-		p.SendResponse(msg, mustMarshal(&pb.GetUserInfoResponse{
-			Ret: pb.ErrorCode_AUTH_FAILED,
-		}))
-		return
+		return NewHandlerError(pb.ErrorCode_AUTH_FAILED, "uid %d does not match the player", req.GetUid())
 	}
 
 	// 在Redis中查找用户信息
@@ -38,27 +34,20 @@ func (p *Player) HandleGetUserInfoRequest(msg *pb.Message) {
 	// 尝试获取用户信息
 	userInfo, err := GlobalRedis.HGetAll(userKey)
 	if err != nil && err != redis.ErrNil {
-		slog.Error("redis error", "error", err)
-		p.SendResponse(msg, mustMarshal(&pb.AuthResponse{
-			Ret: pb.ErrorCode_SERVER_ERROR,
-		}))
-		return
+		return fmt.Errorf("load user info: %w", err)
 	}
 
 	// 用户不存在则返回错误
 	if len(userInfo) == 0 || userInfo["uid"] == "" {
-		slog.Info("User not found or incomplete data, creating new user", "key", userKey)
-		p.SendResponse(msg, mustMarshal(&pb.GetUserInfoResponse{
-			Ret: pb.ErrorCode_AUTH_FAILED,
-		}))
-		return
+		return NewHandlerError(pb.ErrorCode_AUTH_FAILED, "user %s not found or incomplete", userKey)
 	}
 
 	p.SetUserInfoToPlayer(userInfo)
 
 	slog.Info("get user info from redis", "user_info", userInfo)
 
-	// 解析用户信息
+	// 解析用户信息，背包可能被同时进行的抽卡修改，持有读锁直到序列化完成
+	p.profileMu.RLock()
 	user := &pb.UserInfo{
 		Uid:           p.Uid,
 		Name:          p.Name,
@@ -70,14 +59,18 @@ func (p *Player) HandleGetUserInfoRequest(msg *pb.Message) {
 	}
 
 	slog.Info("got user info detail", "user", user)
-
-	// 返回新用户信息
-	p.SendResponse(msg, mustMarshal(&pb.GetUserInfoResponse{
+	data := mustMarshal(&pb.GetUserInfoResponse{
 		Ret:      pb.ErrorCode_OK,
 		UserInfo: user,
-	}))
+	})
+	p.profileMu.RUnlock()
+
+	// 返回新用户信息
+	p.SendResponse(msg, data)
+	return nil
 }
 
+// BackpackToJsonString 序列化背包并缓存，调用方需持有 profileMu 写锁
 func (p *Player) BackpackToJsonString() string {
 	if p.Backpack == nil {
 		return "" // 允许空背包
@@ -95,6 +88,7 @@ func (p *Player) BackpackToJsonString() string {
 	return p.BackpackJSON
 }
 
+// ParseBackpackJSON 解析背包，调用方需持有 profileMu 写锁
 func (p *Player) ParseBackpackJSON(jsonStr string) error {
 	if jsonStr == "" {
 		return nil // 允许空背包
@@ -113,6 +107,9 @@ func (p *Player) ParseBackpackJSON(jsonStr string) error {
 
 func (p *Player) SetUserInfoToPlayer(userInfo map[string]string) {
 	var err error
+	p.profileMu.Lock()
+	defer p.profileMu.Unlock()
+
 	p.Name = userInfo["name"]
 
 	p.Exp, err = strconv.ParseInt(userInfo["exp"], 10, 64)
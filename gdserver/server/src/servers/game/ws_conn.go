@@ -2,35 +2,90 @@
 package main
 
 import (
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"net"
+	pb "proto"
 	"time"
 
 	"github.com/gorilla/websocket"
 )
 
+// WSConnection WebSocket 连接，一个帧一条消息
+// 二进制编码使用二进制帧（帧内带长度头），文本编码（JSONCodec）使用文本帧
 type WSConnection struct {
-	conn *websocket.Conn
+	conn  *websocket.Conn
+	codec Codec
 }
 
-func NewWSConnection(wsConn *websocket.Conn) *WSConnection {
-	return &WSConnection{conn: wsConn}
+func NewWSConnection(wsConn *websocket.Conn, codec Codec) *WSConnection {
+	// 超过最大长度的帧由 websocket 库拒绝并关闭连接，不会读入内存
+	wsConn.SetReadLimit(int64(MaxFrameSize + frameHeaderSize))
+
+	// 客户端的 WebSocket ping 帧也算作活跃：延长读超时并回复 pong
+	wsConn.SetPingHandler(func(data string) error {
+		wsConn.SetReadDeadline(time.Now().Add(ReadTimeout))
+		err := wsConn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(WriteTimeout))
+		if errors.Is(err, websocket.ErrCloseSent) || isReadTimeout(err) {
+			return nil
+		}
+		return err
+	})
+	wsConn.SetPongHandler(func(string) error {
+		return wsConn.SetReadDeadline(time.Now().Add(ReadTimeout))
+	})
+	return &WSConnection{conn: wsConn, codec: codec}
 }
 
-func (w *WSConnection) Read(b []byte) (n int, err error) {
-	_, message, err := w.conn.ReadMessage()
+func (w *WSConnection) ReadMessage() (*pb.Message, error) {
+	frameType, frame, err := w.conn.ReadMessage()
 	if err != nil {
-		return 0, err
+		if errors.Is(err, websocket.ErrReadLimit) {
+			return nil, fmt.Errorf("%w: websocket frame over %d bytes", ErrFrameTooLarge, MaxFrameSize)
+		}
+		return nil, err
+	}
+
+	body := frame
+	if w.codec.Binary() {
+		if frameType != websocket.BinaryMessage {
+			return nil, fmt.Errorf("%w: expected binary frame", ErrMalformedMessage)
+		}
+		// 帧内的长度头必须与帧长度一致，一个帧只能携带一条消息
+		if len(frame) < frameHeaderSize {
+			return nil, fmt.Errorf("%w: frame too short (%d bytes)", ErrMalformedMessage, len(frame))
+		}
+		length := int(binary.LittleEndian.Uint32(frame))
+		if length != len(frame)-frameHeaderSize {
+			return nil, fmt.Errorf("%w: length header %d, frame body %d bytes", ErrMalformedMessage, length, len(frame)-frameHeaderSize)
+		}
+		body = frame[frameHeaderSize:]
+	} else if frameType != websocket.TextMessage {
+		return nil, fmt.Errorf("%w: expected text frame", ErrMalformedMessage)
 	}
-	copy(b, message)
-	return len(message), nil
+
+	var msg pb.Message
+	if err := w.codec.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedMessage, err)
+	}
+	return &msg, nil
 }
 
-func (w *WSConnection) Write(b []byte) (n int, err error) {
-	err = w.conn.WriteMessage(websocket.BinaryMessage, b)
+func (w *WSConnection) WriteMessage(msg *pb.Message) error {
+	data, err := w.codec.Marshal(msg)
 	if err != nil {
-		return 0, err
+		return err
+	}
+	if !w.codec.Binary() {
+		// 文本编码一个文本帧一条消息，不带长度头
+		return w.conn.WriteMessage(websocket.TextMessage, data)
 	}
-	return len(b), nil
+	return w.conn.WriteMessage(websocket.BinaryMessage, appendFrameHeader(data))
+}
+
+func (w *WSConnection) Codec() Codec {
+	return w.codec
 }
 
 func (w *WSConnection) Close() error {
@@ -41,10 +96,6 @@ func (w *WSConnection) RemoteAddr() net.Addr {
 	return w.conn.RemoteAddr()
 }
 
-func (w *WSConnection) SetDeadline(t time.Time) error {
-	return w.conn.SetReadDeadline(t)
-}
-
 func (w *WSConnection) SetReadDeadline(t time.Time) error {
 	return w.conn.SetReadDeadline(t)
 }
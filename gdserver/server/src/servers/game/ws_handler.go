@@ -9,8 +9,10 @@ import (
 
 var upgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool {
-		return true // 生产环境应根据需要检查Origin
+		return GlobalOriginPolicy.CheckOrigin(r)
 	},
+	// 客户端可通过 Sec-WebSocket-Protocol 选择编码，不指定时使用 protobuf
+	Subprotocols: []string{ProtoCodec{}.Name(), JSONCodec{}.Name()},
 }
 
 func handleWebSocket(w http.ResponseWriter, r *http.Request) {
@@ -20,9 +22,25 @@ func handleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	codec := selectWSCodec(wsConn.Subprotocol(), r.URL.Query().Get("codec"))
+	slog.Info("WebSocket connected", "remote_addr", wsConn.RemoteAddr().String(), "codec", codec.Name())
+
 	// 创建WSConnection包装器
-	conn := NewWSConnection(wsConn)
+	conn := NewWSConnection(wsConn, codec)
 
 	// 使用与TCP相同的连接处理逻辑
 	go handleConnection(conn)
 }
+
+// selectWSCodec 按协商的子协议或 ?codec= 参数选择编码，子协议优先
+// 浏览器中设置子协议不方便时（如部分小游戏平台）可使用参数
+func selectWSCodec(subprotocol, query string) Codec {
+	name := subprotocol
+	if name == "" {
+		name = query
+	}
+	if name == (JSONCodec{}).Name() {
+		return JSONCodec{}
+	}
+	return ProtoCodec{}
+}
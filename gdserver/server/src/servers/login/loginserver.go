@@ -11,9 +11,10 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 
+	"common/metrics"
 	"common/redisutil" // 根据实际路径修改
+	"common/session"
 )
 
 // 配置信息
@@ -50,22 +51,24 @@ type LoginRequest struct {
 
 // 客户端登录响应
 type LoginResponse struct {
-	Success    bool   `json:"success"`
-	GatewayURL string `json:"gateway_url,omitempty"`
-	SessionID  string `json:"session_id,omitempty"`
-	Username   string `json:"username,omitempty"`
-	OpenID     string `json:"openid,omitempty"` // 添加OpenID到响应
-	Error      string `json:"error,omitempty"`
-	ExpiresIn  int64  `json:"expires_in,omitempty"`
+	Success      bool   `json:"success"`
+	GatewayURL   string `json:"gateway_url,omitempty"`
+	SessionID    string `json:"session_id,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"` // 用于 /refresh 续期会话
+	Username     string `json:"username,omitempty"`
+	OpenID       string `json:"openid,omitempty"` // 添加OpenID到响应
+	Error        string `json:"error,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
 }
 
 // Redis session 结构
 type SessionData struct {
-	OpenID    string `json:"openid"`
-	Username  string `json:"username"`
-	LoginTime int64  `json:"login_time"`
-	ExpiresAt int64  `json:"expires_at"`
-	AppID     string `json:"app_id"`
+	OpenID       string `json:"openid"`
+	Username     string `json:"username"`
+	LoginTime    int64  `json:"login_time"`
+	ExpiresAt    int64  `json:"expires_at"`
+	AppID        string `json:"app_id"`
+	RefreshToken string `json:"refresh_token,omitempty"` // 撤销会话时一并删除
 }
 
 // LoginServer 结构
@@ -113,10 +116,22 @@ func main() {
 		router: gin.Default(),
 	}
 
+	// 启动指标服务
+	metrics.Serve(fmt.Sprintf(":%d", MetricsPort))
+	server.router.Use(metricsMiddleware)
+
 	// 设置路由
 	server.router.POST("/login", server.handleLogin) // 统一登录接口，支持普通用户和游客
+	server.router.POST("/refresh", server.handleRefresh)
+	server.router.POST("/logout", server.handleLogout)
 	server.router.GET("/health", server.handleHealthCheck)
 
+	// 运维接口，未配置 LOGIN_ADMIN_TOKEN 时不开放
+	if adminToken := os.Getenv("LOGIN_ADMIN_TOKEN"); adminToken != "" {
+		admin := server.router.Group("/admin", adminAuth(adminToken))
+		admin.POST("/sessions/revoke", server.handleAdminRevoke)
+	}
+
 	// 启动服务器
 	log.Printf("LoginServer starting on port %s", config.Port)
 	if err := server.router.Run(":" + config.Port); err != nil {
@@ -169,22 +184,9 @@ func (s *LoginServer) processNormalLogin(c *gin.Context, req LoginRequest) {
 		return
 	}
 
-	// 生成session ID
-	sessionID := uuid.New().String()
-
-	// 创建session数据
-	now := time.Now()
-	expiresAt := now.Add(24 * time.Hour)
-	sessionData := SessionData{
-		OpenID:    userInfo.OpenID, // 使用从平台返回的OpenID
-		Username:  userInfo.Username,
-		LoginTime: now.Unix(),
-		ExpiresAt: expiresAt.Unix(),
-		AppID:     req.AppID,
-	}
-
-	// 存储session到Redis
-	if err := s.storeSession(sessionID, sessionData); err != nil {
+	// 创建session并存储到Redis（使用从平台返回的OpenID）
+	sessionID, refreshToken, err := s.createSession(userInfo.OpenID, userInfo.Username, req.AppID)
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, LoginResponse{
 			Success: false,
 			Error:   "Failed to create session: " + err.Error(),
@@ -194,12 +196,13 @@ func (s *LoginServer) processNormalLogin(c *gin.Context, req LoginRequest) {
 
 	// 返回成功响应
 	c.JSON(http.StatusOK, LoginResponse{
-		Success:    true,
-		GatewayURL: s.config.GatewayLBURL,
-		SessionID:  sessionID,
-		Username:   userInfo.Username,
-		OpenID:     userInfo.OpenID, // 返回OpenID给客户端
-		ExpiresIn:  86400,           // 24小时
+		Success:      true,
+		GatewayURL:   s.config.GatewayLBURL,
+		SessionID:    sessionID,
+		RefreshToken: refreshToken,
+		Username:     userInfo.Username,
+		OpenID:       userInfo.OpenID, // 返回OpenID给客户端
+		ExpiresIn:    int64(SessionTTL.Seconds()),
 	})
 }
 func (s *LoginServer) validatePlatformToken(token, appid string) (*PlatformAuthResponse, error) {
@@ -259,10 +262,7 @@ func (s *LoginServer) validatePlatformToken(token, appid string) (*PlatformAuthR
 // 存储session到Redis
 func (s *LoginServer) storeSession(sessionID string, data SessionData) error {
 	// 使用RedisPool的SetJSON方法存储session数据
-	key := fmt.Sprintf("session:%s", sessionID)
-	expiration := 24 * time.Hour
-
-	if err := s.redis.SetJSON(key, data, expiration); err != nil {
+	if err := s.redis.SetJSON(session.Key(sessionID), data, SessionTTL); err != nil {
 		return fmt.Errorf("failed to store session in Redis: %v", err)
 	}
 
@@ -319,25 +319,10 @@ func (s *LoginServer) processGuestLogin(c *gin.Context, req LoginRequest) {
 	guestUsername := "guest_" + req.DeviceID
 	log.Printf("Created guest identity: username=%s, openid=%s", guestUsername, guestOpenID)
 
-	// 生成session ID用于保持流程通用
-	sessionID := uuid.New().String()
-	log.Printf("Generated session ID: %s", sessionID)
-
-	// 创建游客session数据
-	now := time.Now()
-	expiresAt := now.Add(24 * time.Hour)
-	sessionData := SessionData{
-		OpenID:    guestOpenID,
-		Username:  guestUsername,
-		LoginTime: now.Unix(),
-		ExpiresAt: expiresAt.Unix(),
-		AppID:     req.AppID,
-	}
-	log.Printf("Created session data: %+v", sessionData)
-
-	// 存储session到Redis
+	// 创建游客session并存储到Redis，用于保持流程通用
 	log.Printf("Storing session to Redis...")
-	if err := s.storeSession(sessionID, sessionData); err != nil {
+	sessionID, refreshToken, err := s.createSession(guestOpenID, guestUsername, req.AppID)
+	if err != nil {
 		log.Printf("Failed to store session: %v", err)
 		c.JSON(http.StatusInternalServerError, LoginResponse{
 			Success: false,
@@ -345,7 +330,7 @@ func (s *LoginServer) processGuestLogin(c *gin.Context, req LoginRequest) {
 		})
 		return
 	}
-	log.Printf("Successfully stored session to Redis")
+	log.Printf("Successfully stored session to Redis: %s", sessionID)
 
 	// 可以在这里做一些游客登录的控制逻辑
 	// 比如：分配较少的资源、设置不同的限制等
@@ -353,12 +338,13 @@ func (s *LoginServer) processGuestLogin(c *gin.Context, req LoginRequest) {
 
 	// 返回游客认证信息（现在包含SessionID以保持流程通用）
 	response := LoginResponse{
-		Success:    true,
-		GatewayURL: s.config.GatewayLBURL,
-		SessionID:  sessionID, // 返回sessionID保持流程通用
-		Username:   guestUsername,
-		OpenID:     guestOpenID,
-		ExpiresIn:  86400, // 24小时
+		Success:      true,
+		GatewayURL:   s.config.GatewayLBURL,
+		SessionID:    sessionID, // 返回sessionID保持流程通用
+		RefreshToken: refreshToken,
+		Username:     guestUsername,
+		OpenID:       guestOpenID,
+		ExpiresIn:    int64(SessionTTL.Seconds()),
 	}
 	log.Printf("Sending successful response: %+v", response)
 	c.JSON(http.StatusOK, response)
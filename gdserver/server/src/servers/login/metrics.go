@@ -0,0 +1,33 @@
+package main
+
+import (
+	"common/metrics"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsPort LoginServer 指标端口，可通过 METRICS_ADDR 覆盖
+const MetricsPort = 9101
+
+var (
+	httpRequests = metrics.NewCounterVec("login_http_requests_total",
+		"按接口和状态码统计的HTTP请求数", "path", "status")
+	httpDuration = metrics.NewHistogramVec("login_http_request_duration_seconds",
+		"按接口统计的HTTP请求耗时", nil, "path")
+)
+
+// metricsMiddleware 记录每个接口的请求数和耗时
+func metricsMiddleware(c *gin.Context) {
+	start := time.Now()
+	c.Next()
+
+	// 使用路由模板而不是原始路径，避免未知路径产生大量标签
+	path := c.FullPath()
+	if path == "" {
+		path = "unmatched"
+	}
+	httpRequests.WithLabelValues(path, strconv.Itoa(c.Writer.Status())).Inc()
+	httpDuration.WithLabelValues(path).ObserveSince(start)
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"common/redisutil"
+	"common/session"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SessionTTL 会话有效期，客户端在到期前用刷新令牌续期，刷新令牌与会话同时过期
+var SessionTTL = loadDurationFromEnv("LOGIN_SESSION_TTL", 24*time.Hour)
+
+// errSessionExpired 刷新时会话已过期，需要重新登录
+// 不能以同一身份创建新会话：游戏连接仍持有旧的会话ID，下次心跳时会被断开
+var errSessionExpired = errors.New("session expired")
+
+// RefreshData 刷新令牌对应的登录身份
+type RefreshData struct {
+	SessionID string `json:"session_id"`
+	OpenID    string `json:"openid"`
+	Username  string `json:"username"`
+	AppID     string `json:"app_id"`
+}
+
+// 刷新请求
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// 登出请求
+type LogoutRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+// 撤销会话请求（运维接口）
+type RevokeRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+// createSession 创建会话和对应的刷新令牌
+func (s *LoginServer) createSession(openID, username, appID string) (sessionID, refreshToken string, err error) {
+	sessionID = uuid.New().String()
+	refreshToken = uuid.New().String()
+
+	now := time.Now()
+	sessionData := SessionData{
+		OpenID:       openID,
+		Username:     username,
+		LoginTime:    now.Unix(),
+		ExpiresAt:    now.Add(SessionTTL).Unix(),
+		AppID:        appID,
+		RefreshToken: refreshToken,
+	}
+	if err := s.storeSession(sessionID, sessionData); err != nil {
+		return "", "", err
+	}
+	if err := s.storeRefreshToken(refreshToken, RefreshData{
+		SessionID: sessionID,
+		OpenID:    openID,
+		Username:  username,
+		AppID:     appID,
+	}); err != nil {
+		return "", "", err
+	}
+	return sessionID, refreshToken, nil
+}
+
+func (s *LoginServer) storeRefreshToken(refreshToken string, data RefreshData) error {
+	if err := s.redis.SetJSON(session.RefreshKey(refreshToken), data, SessionTTL); err != nil {
+		return fmt.Errorf("failed to store refresh token in Redis: %v", err)
+	}
+	return nil
+}
+
+// 刷新会话：延长会话有效期并更换刷新令牌（旧令牌作废）
+// 刷新令牌读取后立即删除，同一令牌并发刷新时只有一个成功；会话已过期或被撤销时无法刷新，需要重新登录
+func (s *LoginServer) handleRefresh(c *gin.Context) {
+	var req RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.RefreshToken == "" {
+		c.JSON(http.StatusBadRequest, LoginResponse{
+			Success: false,
+			Error:   "Invalid request format",
+		})
+		return
+	}
+
+	var refresh RefreshData
+	if err := s.redis.GetDelJSON(session.RefreshKey(req.RefreshToken), &refresh); err != nil {
+		if errors.Is(err, redisutil.ErrKeyNotFound) {
+			c.JSON(http.StatusUnauthorized, LoginResponse{
+				Success: false,
+				Error:   "Refresh token invalid or expired",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, LoginResponse{
+			Success: false,
+			Error:   "Failed to load refresh token: " + err.Error(),
+		})
+		return
+	}
+
+	sessionID, refreshToken, err := s.extendSession(refresh)
+	if errors.Is(err, errSessionExpired) {
+		c.JSON(http.StatusUnauthorized, LoginResponse{
+			Success: false,
+			Error:   "Session expired, please log in again",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, LoginResponse{
+			Success: false,
+			Error:   "Failed to refresh session: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("Session refreshed: openid=%s, session=%s", refresh.OpenID, sessionID)
+	c.JSON(http.StatusOK, LoginResponse{
+		Success:      true,
+		GatewayURL:   s.config.GatewayLBURL,
+		SessionID:    sessionID,
+		RefreshToken: refreshToken,
+		Username:     refresh.Username,
+		OpenID:       refresh.OpenID,
+		ExpiresIn:    int64(SessionTTL.Seconds()),
+	})
+}
+
+// extendSession 延长会话并生成新的刷新令牌，会话已不存在时返回 errSessionExpired
+func (s *LoginServer) extendSession(refresh RefreshData) (sessionID, refreshToken string, err error) {
+	var data SessionData
+	if err := s.redis.GetJSON(session.Key(refresh.SessionID), &data); err != nil {
+		if errors.Is(err, redisutil.ErrKeyNotFound) {
+			return "", "", errSessionExpired
+		}
+		return "", "", err
+	}
+
+	refreshToken = uuid.New().String()
+	data.ExpiresAt = time.Now().Add(SessionTTL).Unix()
+	data.RefreshToken = refreshToken
+	if err := s.storeSession(refresh.SessionID, data); err != nil {
+		return "", "", err
+	}
+	refresh.Username = data.Username
+	if err := s.storeRefreshToken(refreshToken, refresh); err != nil {
+		return "", "", err
+	}
+	return refresh.SessionID, refreshToken, nil
+}
+
+// 登出：删除会话并断开使用该会话的游戏连接
+func (s *LoginServer) handleLogout(c *gin.Context) {
+	var req LogoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.SessionID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Invalid request format"})
+		return
+	}
+	if err := session.Revoke(s.redis, req.SessionID, session.ReasonLogout); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+	log.Printf("Session logged out: session=%s", req.SessionID)
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// 撤销会话（运维接口），需要 X-Internal-Auth: LOGIN_ADMIN_TOKEN
+func (s *LoginServer) handleAdminRevoke(c *gin.Context) {
+	var req RevokeRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.SessionID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "Invalid request format"})
+		return
+	}
+	if err := session.Revoke(s.redis, req.SessionID, session.ReasonAdmin); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+	log.Printf("Session revoked by admin: session=%s, remote=%s", req.SessionID, c.ClientIP())
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// adminAuth 校验运维接口的内部令牌
+func adminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		got := c.GetHeader("X-Internal-Auth")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"success": false, "error": "unauthorized"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// loadDurationFromEnv 从环境变量读取时长配置，未设置或格式错误时使用默认值
+func loadDurationFromEnv(name string, def time.Duration) time.Duration {
+	if v := os.Getenv(name); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+		log.Printf("Invalid duration in env %s=%q, using default %s", name, v, def)
+	}
+	return def
+}
@@ -1,13 +1,18 @@
 package main
 
 import (
+	"common/metrics"
 	"common/redisutil"
+	"common/rpc"
+	"common/tracing"
+	"context"
 	"fmt"
 	"log/slog"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	pb "proto"
 
@@ -21,11 +26,14 @@ var (
 
 func main() {
 	// 初始化日志
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	logger := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelDebug,
-	}))
+	})))
 	slog.SetDefault(logger)
 
+	// 初始化调用链追踪（TRACE_EXPORTER 为空时不导出）
+	stopTracing := tracing.Init("match")
+
 	// 初始化Redis连接池
 	redisConfig := redisutil.LoadRedisConfigFromEnv()
 	GlobalRedis = redisutil.NewRedisPoolFromConfig(redisConfig)
@@ -38,30 +46,42 @@ func main() {
 
 	matchServer := NewOptimizedMatchServer()
 
+	// 启动指标服务
+	matchServer.registerMetrics()
+	metrics.Serve(fmt.Sprintf(":%d", MetricsPort))
+
 	// 启动gRPC服务器
-	lis, err := net.Listen("tcp", ":50052")
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", rpc.MatchServiceGRPCPort))
 	if err != nil {
 		slog.Error("failed to listen", "error", err)
 		os.Exit(1)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(tracing.ServerOption())
 	pb.RegisterMatchRpcServiceServer(grpcServer, matchServer)
 
+	// 注册服务发现
+	deregister := registerServiceDiscovery(rpc.MatchServiceGRPCPort)
+
 	// 优雅退出
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
 		sig := <-sigCh
 		slog.Info("received signal, shutting down", "signal", sig)
+		deregister()
 		grpcServer.GracefulStop()
 	}()
 
-	slog.Info("MatchServer started", "port", 50052)
+	slog.Info("MatchServer started", "port", rpc.MatchServiceGRPCPort)
 	if err := grpcServer.Serve(lis); err != nil {
 		slog.Error("failed to serve", "error", err)
 		os.Exit(1)
 	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	stopTracing(ctx)
+	cancel()
 }
 
 // 测试Redis连接
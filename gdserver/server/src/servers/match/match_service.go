@@ -1,8 +1,11 @@
 package main
 
 import (
+	"common/discovery"
 	"common/rpc"
+	"common/tracing"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -33,32 +36,25 @@ type OptimizedMatchServer struct {
 	lastActivity map[uint64]time.Time           // 玩家最后活动时间（用于超时）
 
 	// gRPC 连接池
-	roomConn *grpc.ClientConn
-	gameConn *grpc.ClientConn
+	battleRouter *discovery.BattleRouter     // 按负载选择创建房间的 BattleServer
+	roomConns    map[string]*grpc.ClientConn // BattleServer 地址到连接的映射
+	roomConnsMu  sync.Mutex
+	gameConn     *grpc.ClientConn
 }
 
 func NewOptimizedMatchServer() *OptimizedMatchServer {
 	server := &OptimizedMatchServer{
 		matchQueue:   make(map[uint64]*pb.MatchRpcRequest),
 		lastActivity: make(map[uint64]time.Time),
-	}
-
-	// 建立到 Room Server 的连接
-	roomConn, err := grpc.Dial(
-		RoomServerAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		slog.Error("Failed to connect to Room Server", "error", err)
-	} else {
-		server.roomConn = roomConn
-		slog.Info("Connected to Room Server", "addr", RoomServerAddr)
+		battleRouter: discovery.NewBattleRouter(GlobalRedis, "prod_"),
+		roomConns:    make(map[string]*grpc.ClientConn),
 	}
 
 	// 建立到 Game Server 的连接
 	gameConn, err := grpc.Dial(
 		GameServerAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		tracing.DialOption(),
 	)
 	if err != nil {
 		slog.Error("Failed to connect to Game Server", "error", err)
@@ -74,14 +70,45 @@ func NewOptimizedMatchServer() *OptimizedMatchServer {
 }
 
 func (s *OptimizedMatchServer) Close() {
-	if s.roomConn != nil {
-		s.roomConn.Close()
+	s.roomConnsMu.Lock()
+	for _, conn := range s.roomConns {
+		conn.Close()
 	}
+	s.roomConnsMu.Unlock()
 	if s.gameConn != nil {
 		s.gameConn.Close()
 	}
 }
 
+// roomClient 按负载选择一个 BattleServer，返回其客户端（连接按地址复用）
+func (s *OptimizedMatchServer) roomClient(ctx context.Context) (pb.RoomRpcServiceClient, string, error) {
+	addr := RoomServerAddr
+	instance, err := s.battleRouter.PickInstance(ctx)
+	if err != nil {
+		if !errors.Is(err, discovery.ErrNoBattleInstance) {
+			return nil, "", err
+		}
+		slog.Warn("No battle server discovered, using default address", "addr", addr)
+	} else {
+		addr = instance.Address
+	}
+
+	s.roomConnsMu.Lock()
+	defer s.roomConnsMu.Unlock()
+
+	conn, ok := s.roomConns[addr]
+	if !ok {
+		conn, err = grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+			tracing.DialOption())
+		if err != nil {
+			return nil, "", err
+		}
+		s.roomConns[addr] = conn
+		slog.Info("Connected to Room Server", "addr", addr)
+	}
+	return pb.NewRoomRpcServiceClient(conn), addr, nil
+}
+
 func (s *OptimizedMatchServer) StartMatchRpc(ctx context.Context, req *pb.MatchRpcRequest) (*pb.MatchRpcResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -111,6 +138,7 @@ func (s *OptimizedMatchServer) CancelMatchRpc(ctx context.Context, req *pb.Cance
 	slog.Info("Player canceling match", "player_id", playerID)
 
 	if _, exists := s.matchQueue[playerID]; exists {
+		s.observeMatchWait(playerID, "cancelled", time.Now())
 		delete(s.matchQueue, playerID)
 		delete(s.lastActivity, playerID)
 		slog.Info("Player removed from match queue", "player_id", playerID, "queue_size", len(s.matchQueue))
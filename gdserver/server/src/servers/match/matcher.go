@@ -1,6 +1,7 @@
 package main
 
 import (
+	"common/tracing"
 	"context"
 	"log/slog"
 	pb "proto"
@@ -39,13 +40,14 @@ func (s *OptimizedMatchServer) tryMatch() {
 		s.mu.Lock()
 		// 双重检查，确保玩家还在队列中
 		if _, exists := s.matchQueue[playerID]; exists {
+			s.observeMatchWait(playerID, "timeout", now)
 			delete(s.matchQueue, playerID)
 			delete(s.lastActivity, playerID)
 			s.mu.Unlock()
 
 			slog.Info("Player match timeout", "player_id", playerID)
 			// 通知客户端匹配失败
-			go s.notifyMatchFailed(playerID, "匹配超时")
+			go s.notifyMatchFailed(context.Background(), playerID, "匹配超时")
 		} else {
 			s.mu.Unlock()
 		}
@@ -62,6 +64,7 @@ func (s *OptimizedMatchServer) tryMatch() {
 		if len(matchedPlayers) == 2 {
 			// 从队列中移除这些玩家
 			for _, player := range matchedPlayers {
+				s.observeMatchWait(player.PlayerId, "matched", now)
 				delete(s.matchQueue, player.PlayerId)
 				delete(s.lastActivity, player.PlayerId)
 			}
@@ -84,16 +87,26 @@ func (s *OptimizedMatchServer) tryMatch() {
 
 // createMatchRoom 创建匹配房间
 func (s *OptimizedMatchServer) createMatchRoom(players []*pb.MatchRpcRequest) {
-	if s.roomConn == nil {
-		slog.Error("Room server connection not available")
+	// 匹配成功由后台协程触发，开始新的调用链，建房和结果通知都归入其中
+	ctx, span := tracing.Start(context.Background(), "match.create_room", tracing.Int("players", len(players)))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	roomClient, roomAddr, err := s.roomClient(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Room server connection not available", "error", err)
+		span.SetError(err)
+		matchRooms.WithLabelValues("no_battle_server").Inc()
 		// 通知所有玩家匹配失败
 		for _, player := range players {
-			s.notifyMatchFailed(player.PlayerId, "服务器连接失败")
+			s.notifyMatchFailed(ctx, player.PlayerId, "服务器连接失败")
 		}
 		return
 	}
 
-	slog.Info("Creating match room", "players", len(players))
+	slog.InfoContext(ctx, "Creating match room", "players", len(players), "battle_server", roomAddr)
 
 	// 构建创建房间请求
 	var playerDataList []*pb.PlayerInitData
@@ -107,52 +120,55 @@ func (s *OptimizedMatchServer) createMatchRoom(players []*pb.MatchRpcRequest) {
 		slog.Info("Adding player to match room request", "player_id", player.PlayerId, "player_name", playerData.PlayerName)
 	}
 
-	roomClient := pb.NewRoomRpcServiceClient(s.roomConn)
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
 	// 调用 Room Server 创建匹配房间
 	resp, err := roomClient.MatchCreateRoomRpc(ctx, &pb.MatchCreateRoomRpcRequest{
 		Player: playerDataList,
 	})
 
 	if err != nil {
-		slog.Error("Failed to create match room via RPC", "error", err)
+		slog.ErrorContext(ctx, "Failed to create match room via RPC", "error", err)
+		span.SetError(err)
+		matchRooms.WithLabelValues("rpc_error").Inc()
 		// 通知所有玩家匹配失败
 		for _, player := range players {
-			s.notifyMatchFailed(player.PlayerId, "创建房间失败")
+			s.notifyMatchFailed(ctx, player.PlayerId, "创建房间失败")
 		}
 		return
 	}
 
 	if resp.Ret != pb.ErrorCode_OK {
-		slog.Error("Create match room failed", "error_code", resp.Ret)
+		slog.ErrorContext(ctx, "Create match room failed", "error_code", resp.Ret)
+		span.SetErrorMessage(resp.Ret.String())
+		matchRooms.WithLabelValues(resp.Ret.String()).Inc()
 		// 通知所有玩家匹配失败
 		for _, player := range players {
-			s.notifyMatchFailed(player.PlayerId, "创建房间失败")
+			s.notifyMatchFailed(ctx, player.PlayerId, "创建房间失败")
 		}
 		return
 	}
 
-	slog.Info("Match room created successfully",
+	matchRooms.WithLabelValues(pb.ErrorCode_OK.String()).Inc()
+	span.SetAttributes(tracing.String("room_id", resp.Room.Room.Id))
+	slog.InfoContext(ctx, "Match room created successfully",
 		"room_id", resp.Room.Room.Id,
 		"players", len(players))
 
 	// 通知所有玩家匹配成功
 	for _, player := range players {
-		s.notifyMatchSuccess(player.PlayerId, resp.Room)
+		s.notifyMatchSuccess(ctx, player.PlayerId, resp.Room)
 	}
 }
 
 // notifyMatchSuccess 通知玩家匹配成功
-func (s *OptimizedMatchServer) notifyMatchSuccess(playerID uint64, room *pb.RoomDetail) {
+func (s *OptimizedMatchServer) notifyMatchSuccess(ctx context.Context, playerID uint64, room *pb.RoomDetail) {
 	if s.gameConn == nil {
 		slog.Error("Game server connection not available", "player_id", playerID)
+		notifyFailures.WithLabelValues("no_connection").Inc()
 		return
 	}
 
 	gameClient := pb.NewGameRpcServiceClient(s.gameConn)
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
 	defer cancel()
 
 	// 通知 Game Server，由它推送给客户端
@@ -164,8 +180,12 @@ func (s *OptimizedMatchServer) notifyMatchSuccess(playerID uint64, room *pb.Room
 		},
 	}
 
-	_, err := gameClient.MatchResultNotifyRpc(ctx, notify)
+	resp, err := gameClient.MatchResultNotifyRpc(ctx, notify)
+	if err == nil && resp.Ret != int32(pb.ErrorCode_OK) {
+		notifyFailures.WithLabelValues(pb.ErrorCode(resp.Ret).String()).Inc()
+	}
 	if err != nil {
+		notifyFailures.WithLabelValues("rpc_error").Inc()
 		slog.Error("Failed to notify player match success", "player_id", playerID, "error", err)
 	} else {
 		slog.Info("Notified player match success", "player_id", playerID, "room_id", room.Room.Id)
@@ -173,14 +193,15 @@ func (s *OptimizedMatchServer) notifyMatchSuccess(playerID uint64, room *pb.Room
 }
 
 // notifyMatchFailed 通知玩家匹配失败
-func (s *OptimizedMatchServer) notifyMatchFailed(playerID uint64, reason string) {
+func (s *OptimizedMatchServer) notifyMatchFailed(ctx context.Context, playerID uint64, reason string) {
 	if s.gameConn == nil {
 		slog.Error("Game server connection not available", "player_id", playerID)
+		notifyFailures.WithLabelValues("no_connection").Inc()
 		return
 	}
 
 	gameClient := pb.NewGameRpcServiceClient(s.gameConn)
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
 	defer cancel()
 
 	// 通知 Game Server 匹配失败
@@ -192,8 +213,12 @@ func (s *OptimizedMatchServer) notifyMatchFailed(playerID uint64, reason string)
 		},
 	}
 
-	_, err := gameClient.MatchResultNotifyRpc(ctx, notify)
+	resp, err := gameClient.MatchResultNotifyRpc(ctx, notify)
+	if err == nil && resp.Ret != int32(pb.ErrorCode_OK) {
+		notifyFailures.WithLabelValues(pb.ErrorCode(resp.Ret).String()).Inc()
+	}
 	if err != nil {
+		notifyFailures.WithLabelValues("rpc_error").Inc()
 		slog.Error("Failed to notify player match failed",
 			"player_id", playerID,
 			"reason", reason,
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"common/metrics"
+	"time"
+)
+
+// MetricsPort MatchServer 指标端口，可通过 METRICS_ADDR 覆盖
+const MetricsPort = 9104
+
+var (
+	matchWait = metrics.NewHistogramVec("match_wait_seconds",
+		"玩家离开匹配队列前的等待时间，result 为 matched/timeout/cancelled",
+		[]float64{1, 2, 5, 10, 15, 20, 30, 60}, "result")
+	matchRooms = metrics.NewCounterVec("match_rooms_total",
+		"匹配成功后创建房间的结果", "result")
+	notifyFailures = metrics.NewCounterVec("match_notify_failures_total",
+		"发往GameServer的匹配结果通知失败数", "reason")
+)
+
+// registerMetrics 注册依赖匹配队列的指标
+func (s *OptimizedMatchServer) registerMetrics() {
+	metrics.NewGaugeFunc("match_queue_size", "当前匹配队列中的玩家数", func() float64 {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return float64(len(s.matchQueue))
+	})
+}
+
+// observeMatchWait 记录玩家离开匹配队列时的等待时间（调用方持有 s.mu）
+func (s *OptimizedMatchServer) observeMatchWait(playerID uint64, result string, now time.Time) {
+	if joined, ok := s.lastActivity[playerID]; ok {
+		matchWait.WithLabelValues(result).Observe(now.Sub(joined).Seconds())
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"common/discovery"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// registerServiceDiscovery 注册到服务发现，GameServer 据此找到匹配服，返回的函数用于退出时注销
+// MATCH_ADVERTISE_HOST 为写入服务发现的主机地址，默认 127.0.0.1
+func registerServiceDiscovery(port int) func() {
+	host := os.Getenv("MATCH_ADVERTISE_HOST")
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	hostname, _ := os.Hostname()
+	instance := &discovery.ServiceInstance{
+		ServiceName: discovery.MatchServiceName,
+		InstanceID:  fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano()),
+		Address:     fmt.Sprintf("%s:%d", host, port),
+		Metadata: map[string]string{
+			"version": "1.0",
+		},
+	}
+	disc := discovery.NewRedisDiscovery(GlobalRedis, "prod_")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	err := disc.Register(ctx, instance)
+	cancel()
+	if err != nil {
+		// 未注册时 GameServer 使用默认地址，不影响本地单实例运行
+		slog.Error("Failed to register service", "error", err)
+	} else {
+		slog.Info("Service registered", "instance", instance.InstanceID, "address", instance.Address)
+	}
+
+	// 心跳协程：重新注册以刷新实例数据的过期时间
+	stopCh := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			if err := disc.Register(ctx, instance); err != nil {
+				slog.Error("Heartbeat failed", "error", err)
+			}
+			cancel()
+		}
+	}()
+
+	return func() {
+		close(stopCh)
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		if err := disc.Deregister(ctx, instance.InstanceID); err != nil {
+			slog.Error("Failed to deregister service", "error", err)
+		}
+	}
+}